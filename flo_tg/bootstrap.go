@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/go-faster/errors"
 	"gopkg.in/Graylog2/go-gelf.v2/gelf"
@@ -16,25 +18,35 @@ const (
 )
 
 type Bootstrap struct {
-	Storage       *Storage
-	Logger        Logger
-	TgPhone       string
-	TgAppId       int
-	TgAppHash     string
-	TgWorkFolder  string
-	TgLogFileName string
-	ServicePort   int
-	Queue         *Queue
+	Storage           Storage
+	Logger            Logger
+	TgPhone           string
+	TgAppId           int
+	TgAppHash         string
+	TgWorkFolder      string
+	TgLogFileName     string
+	ServicePort       int
+	MonitorNewSources bool
+	Queue             *Queue
+	Feed              *Feed
+	Telegram          *TelegramRuntime
+	TelegramAuth      *TelegramAuth
+	Health            *Health
+
+	// Attachment files are not downloaded when Blobs is nil
+	Blobs              BlobStore
+	AttachmentsMaxSize int64 // bytes, zero for no limit
 }
 
 func (b *Bootstrap) Close() error {
+	// Pending batched messages are inserted before storage is disconnected
+	b.Storage.Close()
+
 	return b.Logger.Close()
 }
 
-func BootstrapFromEnvironment() Bootstrap {
-
-	servicePort := GetenvInt("FLOTG_PORT", 0, false)
-
+// Graylog logger, also copied to stderr
+func LoggerFromEnvironment() Logger {
 	graylogAddr := GetenvStr("GRAYLOG_ADDRESS", "", false)
 
 	// host name of current container (or system) is used for graylog message "source" field
@@ -48,29 +60,132 @@ func BootstrapFromEnvironment() Bootstrap {
 		facility = prefix + "-" + facility
 	}
 
-	logger := NewGraylogTCPLogger(facility, graylogAddr, selfHostname).SetAsDefault().CopyToStderr()
+	return NewGraylogTCPLogger(facility, graylogAddr, selfHostname).SetAsDefault().CopyToStderr()
+}
+
+func BootstrapFromEnvironment() Bootstrap {
+
+	servicePort := GetenvInt("FLOTG_PORT", 0, false)
+
+	// Monitoring is turned on for sources seen first time, when non-zero
+	monitorNewSources := GetenvInt("FLOTG_MONITOR_NEW_SOURCES", 0, true) != 0
+
+	logger := LoggerFromEnvironment()
 
 	logger.Message(gelf.LOG_DEBUG, "bootstrap", "BootstrapFromEnvironment", GetenvMap(
 		"LOG_FACILITY_PREFIX",
 		"GRAYLOG_ADDRESS",
+		"FLOTG_STORAGE",
 		"MONGO_URI",
+		"FLOTG_MONGO_MESSAGES",
+		"FLOTG_MONGO_BATCH_SIZE",
+		"FLOTG_MONGO_BATCH_MS",
+		"FLOTG_SQLITE_PATH",
 		"FLOTG_PORT",
+		"FLOTG_MONITOR_NEW_SOURCES",
 		"TG_PHONE",
 		"TG_APP_ID",
 		"TG_SESSION_PATH",
+		"TG_AUTH",
+		"FLOTG_ATTACHMENTS",
+		"FLOTG_ATTACHMENTS_DIR",
+		"FLOTG_ATTACHMENTS_MAX_MB",
 	))
 
-	mgUri := GetenvStr("MONGO_URI", "mongodb://localhost:27017", true)
+	health := NewHealth()
+
+	queue := NewQueue(200)
+
+	var err error
+
+	// Sources and messages are kept in MongoDB, in a SQLite database file, or in memory for demos and tests (not persisted)
+	var db Storage
+	var mgStorage *storageMongo
+	defaultBlobMode := blob_store_gridfs
+	switch storageMode := GetenvStr("FLOTG_STORAGE", storage_mongo, true); storageMode {
+	case storage_mongo:
+		// Messages of all sources are in one collection, or in a collection per source until migrate-messages is run.
+		// Layout is chosen by collections found in database when not set, see ChooseMessagesLayout
+		messagesLayout := GetenvStr("FLOTG_MONGO_MESSAGES", "", true)
+		if messagesLayout != "" && messagesLayout != mongo_messages_single && messagesLayout != mongo_messages_per_source {
+			log.Fatalf("FLOTG_MONGO_MESSAGES must be %s or %s", mongo_messages_single, mongo_messages_per_source)
+		}
+		mgStorage = NewStorageMongo(GetenvStr("MONGO_URI", "mongodb://localhost:27017", true), Mongo_Database, messagesLayout, logger, health)
+		db = mgStorage
+	case storage_sqlite:
+		db, err = NewStorageSQLite(GetenvStr("FLOTG_SQLITE_PATH", "", false), logger, health)
+		if err != nil {
+			log.Fatal(errors.Wrap(err, "sqlite storage"))
+		}
+		defaultBlobMode = blob_store_none
+	case storage_memory:
+		db = NewStorageMemory(health)
+		defaultBlobMode = blob_store_none
+	default:
+		log.Fatalf("FLOTG_STORAGE must be %s, %s or %s", storage_mongo, storage_sqlite, storage_memory)
+	}
 
-	db := NewStorageMongo(mgUri, Mongo_Database, logger)
 	if err := db.Ping(); err != nil {
-		err = errors.Wrapf(err, "ping mongodb failed")
+		err = errors.Wrapf(err, "ping storage failed")
 		logger.Message(gelf.LOG_CRIT, "bootstrap", "Storage failed", map[string]any{
 			"err": err,
 		})
 		os.Exit(1)
 	}
 
+	if mgStorage != nil {
+		// Saves check collections in known collections registry
+		if err := mgStorage.WarmCollections(context.TODO()); err != nil {
+			err = errors.Wrapf(err, "warm up known collections failed")
+			logger.Message(gelf.LOG_CRIT, "bootstrap", "Storage failed", map[string]any{
+				"err": err,
+			})
+			os.Exit(1)
+		}
+
+		if err := mgStorage.MigrateMonitored(context.TODO()); err != nil {
+			logger.Message(gelf.LOG_CRIT, "bootstrap", "Storage failed", map[string]any{
+				"err": err,
+			})
+			os.Exit(1)
+		}
+
+		if err := mgStorage.ChooseMessagesLayout(context.TODO(), mgStorage.messages); err != nil {
+			logger.Message(gelf.LOG_CRIT, "bootstrap", "Storage failed", map[string]any{
+				"err": err,
+			})
+			os.Exit(1)
+		}
+
+		// New messages are inserted in batches per collection, one at a time when batch size is 1 or less
+		batchSize := GetenvInt("FLOTG_MONGO_BATCH_SIZE", mongo_batch_size_default, true)
+		batchDelay := time.Duration(GetenvInt("FLOTG_MONGO_BATCH_MS", int(mongo_batch_delay_default.Milliseconds()), true)) * time.Millisecond
+		if batchSize > 1 {
+			mgStorage.EnableBatching(queue, batchSize, batchDelay)
+		}
+	}
+
+	// Downloaded attachment files are kept in GridFS of flo_tg database, in a directory, or not downloaded.
+	// Without MongoDB, attachments are not downloaded by default.
+	var blobs BlobStore
+	switch blobMode := GetenvStr("FLOTG_ATTACHMENTS", defaultBlobMode, true); blobMode {
+	case blob_store_gridfs:
+		if mgStorage == nil {
+			log.Fatalf("FLOTG_ATTACHMENTS=%s needs FLOTG_STORAGE=%s", blob_store_gridfs, storage_mongo)
+		}
+		blobs, err = NewBlobStoreGridFS(mgStorage)
+	case blob_store_dir:
+		blobs, err = NewBlobStoreDir(GetenvStr("FLOTG_ATTACHMENTS_DIR", "", false))
+	case blob_store_none:
+	default:
+		log.Fatalf("FLOTG_ATTACHMENTS must be %s, %s or %s", blob_store_gridfs, blob_store_dir, blob_store_none)
+	}
+	if err != nil {
+		log.Fatal(errors.Wrap(err, "attachments blob store"))
+	}
+
+	attachmentsMaxSize := int64(GetenvInt("FLOTG_ATTACHMENTS_MAX_MB", 50, true)) << 20
+
 	phone := GetenvStr("TG_PHONE", "", false)
 
 	appID := GetenvInt("TG_APP_ID", 0, false)
@@ -79,6 +194,12 @@ func BootstrapFromEnvironment() Bootstrap {
 
 	sessionsPath := GetenvStr("TG_SESSION_PATH", "", false)
 
+	// Login with terminal prompts (interactive), or with RPC calls (headless)
+	authMode := GetenvStr("TG_AUTH", tg_auth_mode_terminal, true)
+	if authMode != tg_auth_mode_terminal && authMode != tg_auth_mode_rpc {
+		log.Fatalf("TG_AUTH must be %s or %s", tg_auth_mode_terminal, tg_auth_mode_rpc)
+	}
+
 	sessionDir := filepath.Join(sessionsPath, sessionFolder(phone))
 	if err := os.MkdirAll(sessionDir, 0700); err != nil {
 		err = errors.Wrap(err, "Error mkdir (0700) for path "+sessionDir)
@@ -90,14 +211,22 @@ func BootstrapFromEnvironment() Bootstrap {
 	logger.Message(gelf.LOG_INFO, "bootstrap", fmt.Sprintf("Telegram database is in %s, logs in %s\n", sessionDir, logFilePath))
 
 	return Bootstrap{
-		Logger:        logger,
-		Storage:       db,
-		TgPhone:       phone,
-		TgAppId:       appID,
-		TgAppHash:     appHash,
-		TgWorkFolder:  sessionDir,
-		TgLogFileName: logFilePath,
-		ServicePort:   servicePort,
-		Queue:         NewQueue(200),
+		Logger:            logger,
+		Storage:           db,
+		TgPhone:           phone,
+		TgAppId:           appID,
+		TgAppHash:         appHash,
+		TgWorkFolder:      sessionDir,
+		TgLogFileName:     logFilePath,
+		ServicePort:       servicePort,
+		MonitorNewSources: monitorNewSources,
+		Queue:             queue,
+		Feed:              NewFeed(feed_subscriber_backlog),
+		Telegram:          NewTelegramRuntime(),
+		TelegramAuth:      NewTelegramAuth(phone, authMode == tg_auth_mode_rpc),
+		Health:            health,
+
+		Blobs:              blobs,
+		AttachmentsMaxSize: attachmentsMaxSize,
 	}
 }
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,29 +19,83 @@ import (
 
 type converter struct {
 	bootstrap Bootstrap
+	peerDB    storage.PeerStorage // nil when telegram is not running, forward origins are not resolved
 }
 
-func newConverter(bootstrap Bootstrap) *converter {
+func newConverter(bootstrap Bootstrap, peerDB storage.PeerStorage) *converter {
 	return &converter{
 		bootstrap: bootstrap,
+		peerDB:    peerDB,
 	}
 }
 
-func (c *converter) makeProtoSource(_ *tg.Message, peer storage.Peer, _ tg.Entities, _ *tg.User) (*proto.FLO_SOURCE, int64) {
+// Source uid of telegram peer (user, chat or channel id)
+func makeSourceUid(peerID int64) string {
+	return fmt.Sprintf("tgv1-fromid-%d", peerID)
+}
+
+// Message uid of telegram message id in the source
+func makeMessageUid(sourceUid string, messageID int) string {
+	return fmt.Sprintf("%s-%d", sourceUid, messageID)
+}
+
+// Telegram message id of message uid made by makeMessageUid, zero if uid has no message id
+func messageIDFromUid(messageUid string) int {
+	id, _ := strconv.Atoi(messageUid[strings.LastIndex(messageUid, "-")+1:])
+	return id
+}
+
+// Public username: main username, or the first active one of collectible usernames
+func activeUsername(username string, usernames []tg.Username) string {
+	if username != "" {
+		return username
+	}
 
-	// TODO: proto add detection for username
+	for _, u := range usernames {
+		if u.Active {
+			return u.Username
+		}
+	}
+
+	return ""
+}
+
+// Source flags of telegram user or channel status
+func statusFlags(bot, scam, fake, verified, premium bool) int32 {
+	var flags int32
+	for flag, set := range map[proto.FLAGS]bool{
+		proto.FLAGS_Bot:      bot,
+		proto.FLAGS_Scam:     scam,
+		proto.FLAGS_Fake:     fake,
+		proto.FLAGS_Verified: verified,
+		proto.FLAGS_Premium:  premium,
+	} {
+		if set {
+			flags |= int32(flag)
+		}
+	}
+	return flags
+}
+
+func (c *converter) makeProtoSource(_ *tg.Message, peer storage.Peer, _ tg.Entities, _ *tg.User) (*proto.FLO_SOURCE, int64) {
 
-	// TODO: Add proto flags: scam, bot, fake, premium
 	if peer.Channel != nil {
+		// Public supergroups are channels too (Megagroup) and have usernames the same way
 		v := &proto.FLO_SOURCE{
 			Flags:     int32(proto.FLAGS_V1) | int32(proto.FLAGS_Tg) | int32(proto.FLAGS_Channel),
-			SourceUid: fmt.Sprintf("tgv1-fromid-%d", peer.Channel.ID),
+			SourceUid: makeSourceUid(peer.Channel.ID),
 			Title:     peer.Channel.Title,
 		}
 
-		if peer.Channel.Username != "" {
-			// TODO: v.Flags |= int32(proto.FLAGS_TgUsername)
-			// TODO: v.Username = fmt.Sprintf("https://t.me/@%s", peer.Channel.Username)
+		if username := activeUsername(peer.Channel.Username, peer.Channel.Usernames); username != "" {
+			v.Flags |= int32(proto.FLAGS_TgUsername)
+			v.Username = username
+		}
+
+		v.Flags |= statusFlags(false, peer.Channel.Scam, peer.Channel.Fake, peer.Channel.Verified, false)
+
+		if peer.Channel.Forum {
+			v.Flags |= int32(proto.FLAGS_Forum)
 		}
 
 		return v, peer.Channel.ID
@@ -48,26 +104,28 @@ func (c *converter) makeProtoSource(_ *tg.Message, peer storage.Peer, _ tg.Entit
 
 		v := &proto.FLO_SOURCE{
 			Flags:     int32(proto.FLAGS_V1) | int32(proto.FLAGS_Tg) | int32(proto.FLAGS_User),
-			SourceUid: fmt.Sprintf("tgv1-fromid-%d", peer.User.ID),
+			SourceUid: makeSourceUid(peer.User.ID),
 			Title:     strings.Trim(fmt.Sprintf("%s %s", peer.User.FirstName, peer.User.LastName), " "),
 		}
 
-		if peer.User.Username != "" {
-			// TODO: v.Flags |= int32(proto.FLAGS_TgUsername)
-			// TODO: v.Username = fmt.Sprintf("https://t.me/@%s", peer.User.Username)
+		if username := activeUsername(peer.User.Username, peer.User.Usernames); username != "" {
+			v.Flags |= int32(proto.FLAGS_TgUsername)
+			v.Username = username
 		}
 
+		v.Flags |= statusFlags(peer.User.Bot, peer.User.Scam, peer.User.Fake, peer.User.Verified, peer.User.Premium)
+
 		return v, peer.User.ID
 
 	} else if peer.Chat != nil {
 
 		v := &proto.FLO_SOURCE{
 			Flags:     int32(proto.FLAGS_V1) | int32(proto.FLAGS_Tg) | int32(proto.FLAGS_Group),
-			SourceUid: fmt.Sprintf("tgv1-fromid-%d", peer.Chat.ID),
-			Title:     peer.Channel.Title,
+			SourceUid: makeSourceUid(peer.Chat.ID),
+			Title:     peer.Chat.Title,
 		}
 
-		// TODO: No peer.Chat.Username, how to get group chat username (for a public group)
+		// Basic groups have no username, a group becomes a supergroup (channel) when made public
 
 		return v, peer.Chat.ID
 	}
@@ -77,27 +135,538 @@ func (c *converter) makeProtoSource(_ *tg.Message, peer storage.Peer, _ tg.Entit
 	}, -1
 }
 
-func (c *converter) makeProtoMessage(msg *tg.Message, source *proto.FLO_SOURCE, deepFromId int64) *proto.FLO_MESSAGE {
-
+func makeMessageLinks(source *proto.FLO_SOURCE, deepFromId int64, messageID int) []string {
 	messageDeepLinks := []string{
-		fmt.Sprintf("https://t.me/c/%d/%d", deepFromId, msg.ID),
+		fmt.Sprintf("https://t.me/c/%d/%d", deepFromId, messageID),
 	}
 
-	// TODO: proto add detection for username
-	// if source.Flags&int32(proto.FLAGS_TgUsername) != 0 {
-	// 	s := fmt.Sprintf("https://t.me/%s/%d", source.Username, msg.ID)
-	// 	messageDeepLinks = append(messageDeepLinks, s)
-	// }
+	// Public link, also works for those not in the channel or group
+	if source.Flags&int32(proto.FLAGS_TgUsername) != 0 {
+		s := fmt.Sprintf("https://t.me/%s/%d", source.Username, messageID)
+		messageDeepLinks = append(messageDeepLinks, s)
+	}
+
+	return messageDeepLinks
+}
 
-	return &proto.FLO_MESSAGE{
+func (c *converter) makeProtoMessage(ctx context.Context, msg *tg.Message, source *proto.FLO_SOURCE, deepFromId int64, e tg.Entities, selfUser *tg.User) *proto.FLO_MESSAGE {
+
+	message := &proto.FLO_MESSAGE{
 		Flags:        source.Flags,
 		CreatedAt:    timestamppb.New(time.Unix(int64(msg.Date), 0)),
 		Title:        source.Title,
 		SourceUid:    source.SourceUid,
-		MessageUid:   fmt.Sprintf("%s-%d", source.SourceUid, msg.ID),
+		MessageUid:   makeMessageUid(source.SourceUid, msg.ID),
 		Text:         msg.Message,
-		MessageLinks: messageDeepLinks,
+		MessageLinks: makeMessageLinks(source, deepFromId, msg.ID),
+		Entities:     makeProtoEntities(msg.Entities),
+	}
+
+	fromID, hasFrom := msg.GetFromID()
+	sender := messageSender(fromID, hasFrom, msg.Out, msg.PeerID, selfUser)
+	message.Author = c.makeProtoAuthor(ctx, sender, msg.PostAuthor, e, selfUser)
+
+	if editDate, ok := msg.GetEditDate(); ok {
+		message.EditedAt = timestamppb.New(time.Unix(int64(editDate), 0))
+	}
+
+	if replyTo, ok := msg.GetReplyTo(); ok {
+		message.ReplyToMessageUid, message.ReplyToTopMessageUid, message.TopicId = makeReplyTo(source, replyTo)
+	}
+
+	if attachment, _ := c.makeProtoAttachment(msg); attachment != nil {
+		message.Attachments = append(message.Attachments, attachment)
+	}
+
+	if fwd, ok := msg.GetFwdFrom(); ok {
+		message.Flags |= int32(proto.FLAGS_ForwardFromSource)
+		message.ForwardFromSource = c.makeProtoForwardSource(ctx, fwd)
+		message.ForwardFromCreatedAt = timestamppb.New(time.Unix(int64(fwd.Date), 0))
+
+		if id, ok := fwd.GetChannelPost(); ok {
+			message.ForwardFromMessageId = int32(id)
+		}
+	}
+
+	return message
+}
+
+// Service message is saved as FLO_MESSAGE with Service flag, its text describes the event
+func (c *converter) makeProtoServiceMessage(ctx context.Context, msg *tg.MessageService, source *proto.FLO_SOURCE, deepFromId int64, e tg.Entities, selfUser *tg.User) *proto.FLO_MESSAGE {
+	action := &proto.FLO_SERVICE_ACTION{}
+
+	// Joined or left user is the sender of service message
+	var fromUserIDs []int64
+	if from, ok := msg.GetFromID(); ok {
+		if user, ok := from.(*tg.PeerUser); ok {
+			fromUserIDs = []int64{user.UserID}
+		}
+	}
+
+	switch a := msg.Action.(type) {
+	case *tg.MessageActionPinMessage:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionPin
+		if replyTo, ok := msg.GetReplyTo(); ok {
+			if header, ok := replyTo.(*tg.MessageReplyHeader); ok && header.ReplyToMsgID != 0 {
+				action.PinnedMessageUid = makeMessageUid(source.SourceUid, header.ReplyToMsgID)
+			}
+		}
+	case *tg.MessageActionChatEditTitle:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionTitleChange
+		action.Title = a.Title
+	case *tg.MessageActionChatEditPhoto:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionPhotoChange
+	case *tg.MessageActionChatDeletePhoto:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionPhotoDelete
+	case *tg.MessageActionChatAddUser:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionMembersAdd
+		action.UserIds = a.Users
+	case *tg.MessageActionChatJoinedByLink:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionMemberJoinByLink
+		action.UserIds = fromUserIDs
+	case *tg.MessageActionChatJoinedByRequest:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionMemberJoinByRequest
+		action.UserIds = fromUserIDs
+	case *tg.MessageActionChatDeleteUser:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionMemberLeave
+		action.UserIds = []int64{a.UserID}
+	case *tg.MessageActionGroupCall:
+		if duration, ok := a.GetDuration(); ok {
+			action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallEnd
+			action.Duration = int32(duration)
+		} else {
+			action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallStart
+		}
+	case *tg.MessageActionGroupCallScheduled:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallScheduled
+		action.ScheduledAt = timestamppb.New(time.Unix(int64(a.ScheduleDate), 0))
+	case *tg.MessageActionInviteToGroupCall:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallInvite
+		action.UserIds = a.Users
+	case *tg.MessageActionChatCreate:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionCreate
+		action.Title = a.Title
+		action.UserIds = a.Users
+	case *tg.MessageActionChannelCreate:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionCreate
+		action.Title = a.Title
+	case *tg.MessageActionChatMigrateTo, *tg.MessageActionChannelMigrateFrom:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionMigrate
+	case *tg.MessageActionHistoryClear:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionHistoryClear
+	case *tg.MessageActionPhoneCall:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionPhoneCall
+		if duration, ok := a.GetDuration(); ok {
+			action.Duration = int32(duration)
+		}
+	case *tg.MessageActionTopicCreate:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionTopicCreate
+		action.Title = a.Title
+	case *tg.MessageActionTopicEdit:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionTopicEdit
+		action.Title, _ = a.GetTitle()
+	default:
+		action.Type = proto.FLO_SERVICE_ACTION_TYPE_ActionUnknown
+	}
+
+	message := &proto.FLO_MESSAGE{
+		Flags:         source.Flags | int32(proto.FLAGS_Service),
+		CreatedAt:     timestamppb.New(time.Unix(int64(msg.Date), 0)),
+		Title:         source.Title,
+		SourceUid:     source.SourceUid,
+		MessageUid:    makeMessageUid(source.SourceUid, msg.ID),
+		Text:          serviceActionText(action),
+		MessageLinks:  makeMessageLinks(source, deepFromId, msg.ID),
+		ServiceAction: action,
+	}
+
+	fromID, hasFrom := msg.GetFromID()
+	message.Author = c.makeProtoAuthor(ctx, messageSender(fromID, hasFrom, msg.Out, msg.PeerID, selfUser), "", e, selfUser)
+
+	// Reply of service message is the pinned message, only topic is kept
+	if replyTo, ok := msg.GetReplyTo(); ok {
+		_, _, message.TopicId = makeReplyTo(source, replyTo)
+	}
+
+	return message
+}
+
+// Replied message uid, thread first message uid and forum topic id of a message reply header
+func makeReplyTo(source *proto.FLO_SOURCE, replyTo tg.MessageReplyHeaderClass) (replyUid, topUid string, topicID int32) {
+	header, ok := replyTo.(*tg.MessageReplyHeader)
+	if !ok {
+		return "", "", 0 // reply to story
+	}
+
+	replyID, ok := header.GetReplyToMsgID()
+	if !ok {
+		return "", "", 0
+	}
+
+	// Reply to message of another chat
+	if peer, ok := header.GetReplyToPeerID(); ok {
+		return makeMessageUid(makeSourceUid(peerID(peer)), replyID), "", 0
+	}
+
+	// Top message is only set for replies inside a thread, otherwise replied message is the top one
+	topID, hasTop := header.GetReplyToTopID()
+	if !hasTop {
+		topID = replyID
+	}
+
+	if header.ForumTopic {
+		topicID = int32(topID)
+
+		// Message in a topic, not a reply: "replied" message is the topic first message
+		if !hasTop {
+			return "", makeMessageUid(source.SourceUid, topID), topicID
+		}
+	}
+
+	return makeMessageUid(source.SourceUid, replyID), makeMessageUid(source.SourceUid, topID), topicID
+}
+
+// Sender of message. In private chats sender is not set: it is the chat user, or self for outgoing messages.
+// Nil for channel posts.
+func messageSender(fromID tg.PeerClass, hasFrom, out bool, peer tg.PeerClass, selfUser *tg.User) tg.PeerClass {
+	if hasFrom {
+		return fromID
+	}
+
+	if out && selfUser != nil {
+		return &tg.PeerUser{UserID: selfUser.ID}
+	}
+
+	if _, ok := peer.(*tg.PeerUser); ok {
+		return peer
+	}
+
+	return nil
+}
+
+// Author of message, resolved with entities of the update or peer storage.
+// Not resolved sender has source uid (and user id) only. Nil if there is no sender and no signature.
+func (c *converter) makeProtoAuthor(ctx context.Context, sender tg.PeerClass, signature string, e tg.Entities, selfUser *tg.User) *proto.FLO_AUTHOR {
+	if sender == nil && signature == "" {
+		return nil
+	}
+
+	author := &proto.FLO_AUTHOR{
+		Signature: signature,
+	}
+
+	if sender == nil {
+		return author
+	}
+
+	author.SourceUid = makeSourceUid(peerID(sender))
+
+	var (
+		peer     storage.Peer
+		resolved bool
+	)
+
+	switch p := sender.(type) {
+	case *tg.PeerUser:
+		author.UserId = p.UserID
+
+		if user, ok := e.Users[p.UserID]; ok {
+			resolved = peer.FromUser(user)
+		} else if selfUser != nil && selfUser.ID == p.UserID {
+			resolved = peer.FromUser(selfUser)
+		}
+	case *tg.PeerChannel:
+		if channel, ok := e.Channels[p.ChannelID]; ok {
+			resolved = peer.FromChat(channel)
+		}
+	case *tg.PeerChat:
+		if chat, ok := e.Chats[p.ChatID]; ok {
+			resolved = peer.FromChat(chat)
+		}
+	}
+
+	if !resolved && c.peerDB != nil {
+		var err error
+		if peer, err = storage.FindPeer(ctx, c.peerDB, sender); err == nil {
+			resolved = true
+		}
+	}
+
+	if resolved {
+		source, _ := c.makeProtoSource(nil, peer, e, selfUser)
+		author.DisplayName = source.Title
+		author.Username = source.Username
+	}
+
+	return author
+}
+
+// Telegram id of user, chat or channel peer
+func peerID(peer tg.PeerClass) int64 {
+	switch p := peer.(type) {
+	case *tg.PeerUser:
+		return p.UserID
+	case *tg.PeerChat:
+		return p.ChatID
+	case *tg.PeerChannel:
+		return p.ChannelID
+	}
+	return 0
+}
+
+// Short description of service message event, used as its text
+func serviceActionText(action *proto.FLO_SERVICE_ACTION) string {
+	switch action.Type {
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionPin:
+		return "Message pinned"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionTitleChange:
+		return fmt.Sprintf("Title changed to %q", action.Title)
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionPhotoChange:
+		return "Photo changed"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionPhotoDelete:
+		return "Photo removed"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionMembersAdd:
+		return "Members added"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionMemberJoinByLink:
+		return "Member joined by invite link"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionMemberJoinByRequest:
+		return "Member joined by request"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionMemberLeave:
+		return "Member left"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallStart:
+		return "Group call started"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallEnd:
+		return fmt.Sprintf("Group call ended (%s)", time.Duration(action.Duration)*time.Second)
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallScheduled:
+		return "Group call scheduled at " + action.ScheduledAt.AsTime().UTC().Format(time.RFC3339)
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionGroupCallInvite:
+		return "Invited to group call"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionCreate:
+		return fmt.Sprintf("Created %q", action.Title)
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionMigrate:
+		return "Group migrated to supergroup"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionHistoryClear:
+		return "History cleared"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionPhoneCall:
+		return "Phone call"
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionTopicCreate:
+		return fmt.Sprintf("Topic %q created", action.Title)
+	case proto.FLO_SERVICE_ACTION_TYPE_ActionTopicEdit:
+		return "Topic edited"
+	}
+	return "Service message"
+}
+
+// Source of forwarded message origin, resolved with peer storage.
+// Not resolved origin has only uid (and a title if known), origin hidden by privacy settings has only a title.
+func (c *converter) makeProtoForwardSource(ctx context.Context, fwd tg.MessageFwdHeader) *proto.FLO_SOURCE {
+	fromID, ok := fwd.GetFromID()
+	if !ok {
+		return &proto.FLO_SOURCE{
+			Flags: int32(proto.FLAGS_V1) | int32(proto.FLAGS_Tg) | int32(proto.FLAGS_User),
+			Title: fwd.FromName,
+		}
+	}
+
+	if c.peerDB != nil {
+		peer, err := storage.FindPeer(ctx, c.peerDB, fromID)
+		if err == nil {
+			if source, _ := c.makeProtoSource(nil, peer, tg.Entities{}, nil); source.Flags != int32(proto.FLAGS_Invalid) {
+				return source
+			}
+		} else {
+			c.bootstrap.Logger.Message(gelf.LOG_DEBUG, "converter", "Forward origin peer not found in database", map[string]any{
+				"err":     err.Error(),
+				"from_id": fromID,
+			})
+		}
+	}
+
+	source := &proto.FLO_SOURCE{
+		Flags: int32(proto.FLAGS_V1) | int32(proto.FLAGS_Tg),
+		Title: fwd.FromName,
+	}
+
+	switch p := fromID.(type) {
+	case *tg.PeerUser:
+		source.Flags |= int32(proto.FLAGS_User)
+		source.SourceUid = makeSourceUid(p.UserID)
+	case *tg.PeerChat:
+		source.Flags |= int32(proto.FLAGS_Group)
+		source.SourceUid = makeSourceUid(p.ChatID)
+	case *tg.PeerChannel:
+		source.Flags |= int32(proto.FLAGS_Channel)
+		source.SourceUid = makeSourceUid(p.ChannelID)
+	}
+
+	return source
+}
+
+// Formatting entities of message text, offsets are kept in UTF-16 code units as in telegram
+func makeProtoEntities(entities []tg.MessageEntityClass) []*proto.FLO_ENTITY {
+	var result []*proto.FLO_ENTITY
+
+	for _, entity := range entities {
+		v := &proto.FLO_ENTITY{
+			Offset: int32(entity.GetOffset()),
+			Length: int32(entity.GetLength()),
+		}
+
+		switch e := entity.(type) {
+		case *tg.MessageEntityBold:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityBold
+		case *tg.MessageEntityItalic:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityItalic
+		case *tg.MessageEntityUnderline:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityUnderline
+		case *tg.MessageEntityStrike:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityStrike
+		case *tg.MessageEntitySpoiler:
+			v.Type = proto.FLO_ENTITY_TYPE_EntitySpoiler
+		case *tg.MessageEntityCode:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityCode
+		case *tg.MessageEntityPre:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityPre
+			v.Language = e.Language
+		case *tg.MessageEntityBlockquote:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityBlockquote
+		case *tg.MessageEntityTextURL:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityTextUrl
+			v.Url = e.URL
+		case *tg.MessageEntityURL:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityUrl
+		case *tg.MessageEntityEmail:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityEmail
+		case *tg.MessageEntityPhone:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityPhone
+		case *tg.MessageEntityMention:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityMention
+		case *tg.MessageEntityMentionName:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityMentionName
+			v.UserId = e.UserID
+		case *tg.MessageEntityHashtag:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityHashtag
+		case *tg.MessageEntityCashtag:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityCashtag
+		case *tg.MessageEntityBotCommand:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityBotCommand
+		case *tg.MessageEntityBankCard:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityBankCard
+		case *tg.MessageEntityCustomEmoji:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityCustomEmoji
+			v.DocumentId = e.DocumentID
+		default:
+			v.Type = proto.FLO_ENTITY_TYPE_EntityUnknown
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// Attachment of message media (photo, document, video or voice note) with its file location to download.
+// Returns nil for messages without media or with media that has no file (e.g. geo, poll, web page).
+func (c *converter) makeProtoAttachment(msg *tg.Message) (*proto.FLO_ATTACHMENT, *attachmentDownload) {
+	switch media := msg.Media.(type) {
+
+	case *tg.MessageMediaPhoto:
+		photoClass, ok := media.GetPhoto()
+		if !ok {
+			return nil, nil
+		}
+		photo, ok := photoClass.AsNotEmpty()
+		if !ok {
+			return nil, nil
+		}
+
+		// Largest size of the photo is downloaded
+		var (
+			thumbType     string
+			width, height int
+			size          int
+		)
+		for _, s := range photo.Sizes {
+			switch s := s.(type) {
+			case *tg.PhotoSize:
+				if s.W*s.H > width*height {
+					thumbType, width, height, size = s.Type, s.W, s.H, s.Size
+				}
+			case *tg.PhotoSizeProgressive:
+				if s.W*s.H > width*height && len(s.Sizes) > 0 {
+					thumbType, width, height, size = s.Type, s.W, s.H, s.Sizes[len(s.Sizes)-1]
+				}
+			}
+		}
+		if thumbType == "" {
+			return nil, nil
+		}
+
+		attachment := &proto.FLO_ATTACHMENT{
+			AttachmentUid: fmt.Sprintf("tgv1-photo-%d", photo.ID),
+			Type:          proto.FLO_ATTACHMENT_TYPE_AttachmentPhoto,
+			MimeType:      "image/jpeg",
+			Size:          int64(size),
+			Width:         int32(width),
+			Height:        int32(height),
+		}
+
+		return attachment, &attachmentDownload{
+			AttachmentUid: attachment.AttachmentUid,
+			Size:          attachment.Size,
+			Location: &tg.InputPhotoFileLocation{
+				ID:            photo.ID,
+				AccessHash:    photo.AccessHash,
+				FileReference: photo.FileReference,
+				ThumbSize:     thumbType,
+			},
+		}
+
+	case *tg.MessageMediaDocument:
+		documentClass, ok := media.GetDocument()
+		if !ok {
+			return nil, nil
+		}
+		document, ok := documentClass.AsNotEmpty()
+		if !ok {
+			return nil, nil
+		}
+
+		attachment := &proto.FLO_ATTACHMENT{
+			AttachmentUid: fmt.Sprintf("tgv1-document-%d", document.ID),
+			Type:          proto.FLO_ATTACHMENT_TYPE_AttachmentDocument,
+			MimeType:      document.MimeType,
+			Size:          document.Size,
+		}
+
+		for _, attr := range document.Attributes {
+			switch attr := attr.(type) {
+			case *tg.DocumentAttributeFilename:
+				attachment.FileName = attr.FileName
+			case *tg.DocumentAttributeImageSize:
+				attachment.Width, attachment.Height = int32(attr.W), int32(attr.H)
+			case *tg.DocumentAttributeVideo:
+				attachment.Type = proto.FLO_ATTACHMENT_TYPE_AttachmentVideo
+				attachment.Width, attachment.Height = int32(attr.W), int32(attr.H)
+			case *tg.DocumentAttributeAudio:
+				if attr.Voice {
+					attachment.Type = proto.FLO_ATTACHMENT_TYPE_AttachmentVoice
+				}
+			}
+		}
+
+		return attachment, &attachmentDownload{
+			AttachmentUid: attachment.AttachmentUid,
+			Size:          attachment.Size,
+			Location: &tg.InputDocumentFileLocation{
+				ID:            document.ID,
+				AccessHash:    document.AccessHash,
+				FileReference: document.FileReference,
+			},
+		}
 	}
+
+	return nil, nil
 }
 
 func (c *converter) encodeToJson(m any, pretty bool) string {
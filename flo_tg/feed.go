@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+)
+
+// Feed delivers saved messages to live subscribers (StreamMessages RPC).
+// Publish is called from queue operations and never blocks: a subscriber that is not reading fast enough is dropped.
+type Feed struct {
+	mu          sync.Mutex
+	subscribers map[*FeedSubscription]struct{}
+	backlog     int
+}
+
+type FeedSubscription struct {
+	// Messages are received here, channel is closed when subscription is dropped or cancelled
+	Messages <-chan *proto.FLO_MESSAGE
+
+	messages   chan *proto.FLO_MESSAGE
+	sourceUids map[string]bool // empty for all sources
+	dropped    bool
+}
+
+// Makes new Feed.
+// [backlog] defines number of messages buffered for each subscriber before it is dropped.
+func NewFeed(backlog int) *Feed {
+	return &Feed{
+		subscribers: map[*FeedSubscription]struct{}{},
+		backlog:     backlog,
+	}
+}
+
+// Subscribe to messages of given sources, or all sources if none given.
+// Subscription must be cancelled with Unsubscribe.
+func (feed *Feed) Subscribe(sourceUids ...string) *FeedSubscription {
+	sub := &FeedSubscription{
+		messages:   make(chan *proto.FLO_MESSAGE, feed.backlog),
+		sourceUids: make(map[string]bool, len(sourceUids)),
+	}
+	sub.Messages = sub.messages
+
+	for _, uid := range sourceUids {
+		sub.sourceUids[uid] = true
+	}
+
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	feed.subscribers[sub] = struct{}{}
+
+	return sub
+}
+
+func (feed *Feed) Unsubscribe(sub *FeedSubscription) {
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	if _, ok := feed.subscribers[sub]; ok {
+		delete(feed.subscribers, sub)
+		close(sub.messages)
+	}
+}
+
+// Dropped tells if subscription was closed because subscriber did not read messages in time
+func (feed *Feed) Dropped(sub *FeedSubscription) bool {
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	return sub.dropped
+}
+
+// Hold receives messages of subscription into a buffer not limited by backlog, until returned function is called.
+// Subscriber is not dropped while it is busy with something else (replay of stored messages).
+// Returned function stops receiving and returns buffered messages in order, next messages are received from Messages again.
+func (sub *FeedSubscription) Hold() func() []*proto.FLO_MESSAGE {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	held := []*proto.FLO_MESSAGE{}
+
+	go func() {
+		defer close(done)
+
+		for {
+			select {
+			case <-stop:
+				return
+			case message, ok := <-sub.Messages:
+				if !ok {
+					return
+				}
+				held = append(held, message)
+			}
+		}
+	}()
+
+	return func() []*proto.FLO_MESSAGE {
+		close(stop)
+		<-done
+		return held
+	}
+}
+
+// Publish message to all subscribers of its source
+func (feed *Feed) Publish(message *proto.FLO_MESSAGE) {
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	for sub := range feed.subscribers {
+		if len(sub.sourceUids) > 0 && !sub.sourceUids[message.SourceUid] {
+			continue
+		}
+
+		select {
+		case sub.messages <- message:
+		default:
+			sub.dropped = true
+			delete(feed.subscribers, sub)
+			close(sub.messages)
+		}
+	}
+}
+
+// Key of message version: edited message is published again with the same uid
+func feedMessageKey(message *proto.FLO_MESSAGE) string {
+	if message.EditedAt == nil {
+		return message.MessageUid
+	}
+	return fmt.Sprintf("%s@%d", message.MessageUid, message.EditedAt.AsTime().Unix())
+}
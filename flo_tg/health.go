@@ -0,0 +1,107 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Components of flo_tg health, also registered as service names of grpc.health.v1.
+// Empty service name is overall health: serving only when all components are.
+const (
+	health_queue    = "flo_tg.queue"
+	health_storage  = "flo_tg.storage"
+	health_telegram = "flo_tg.telegram"
+	health_updates  = "flo_tg.updates"
+)
+
+type healthComponent struct {
+	serving   bool
+	message   string
+	updatedAt time.Time
+}
+
+// Health keeps status of flo_tg components, updated by the goroutines running them.
+type Health struct {
+	mu         sync.Mutex
+	server     *health.Server
+	components map[string]healthComponent
+}
+
+// Makes new Health with all components not serving
+func NewHealth() *Health {
+	h := &Health{
+		server:     health.NewServer(),
+		components: map[string]healthComponent{},
+	}
+
+	for _, name := range []string{health_queue, health_storage, health_telegram, health_updates} {
+		h.Set(name, false, "not started")
+	}
+
+	return h
+}
+
+// Server implementing grpc.health.v1, to be registered with gRPC server
+func (h *Health) Server() healthpb.HealthServer {
+	return h.server
+}
+
+// Set component status, message tells the reason (or state) in human readable form
+func (h *Health) Set(name string, serving bool, message string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.components[name] = healthComponent{
+		serving:   serving,
+		message:   message,
+		updatedAt: time.Now().UTC(),
+	}
+
+	h.server.SetServingStatus(name, servingStatus(serving))
+
+	overall := true
+	for _, c := range h.components {
+		overall = overall && c.serving
+	}
+
+	h.server.SetServingStatus("", servingStatus(overall))
+}
+
+// Ready tells if all components are serving, with status of each component
+func (h *Health) Ready() *proto.FlotgReadyResponse {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	response := &proto.FlotgReadyResponse{
+		Ready: true,
+	}
+
+	for name, c := range h.components {
+		response.Ready = response.Ready && c.serving
+		response.Components = append(response.Components, &proto.FlotgComponentStatus{
+			Name:      name,
+			Serving:   c.serving,
+			Message:   c.message,
+			UpdatedAt: timestamppb.New(c.updatedAt),
+		})
+	}
+
+	sort.Slice(response.Components, func(i, j int) bool {
+		return response.Components[i].Name < response.Components[j].Name
+	})
+
+	return response
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
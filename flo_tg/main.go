@@ -16,6 +16,15 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer cancel()
 
+	// Storage maintenance command, telegram client and RPC service are not started
+	if len(os.Args) > 1 && os.Args[1] == command_migrate_messages {
+		if err := MigrateMessagesFromEnvironment(ctx); err != nil {
+			LogErrorln("ERR: " + command_migrate_messages + " failed: " + err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	bootstrap := BootstrapFromEnvironment()
 	defer bootstrap.Close()
 
@@ -43,7 +52,13 @@ func main() {
 
 	bootstrap.Queue.Initialize(ctx)
 
-	go bootstrap.Queue.Run()
+	go func() {
+		bootstrap.Health.Set(health_queue, true, "running")
+		bootstrap.Queue.Run()
+		bootstrap.Health.Set(health_queue, false, "stopped")
+	}()
+
+	go RunStoragePing(ctx, bootstrap.Storage, bootstrap.Logger, storage_ping_interval)
 
 	// BEGIN telegram
 	// TODO: make telegram goroutine, rpc_service synced
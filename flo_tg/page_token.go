@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	rpc_page_size_default = 1000
+	rpc_page_size_max     = 5000
+
+	rpc_trailer_next_page_token = "next-page-token"
+)
+
+// Position after the last message of a page, messages are ordered by (message_created_at, _id)
+type messagesCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// pageToken is the opaque value passed to clients, encoded as base64 JSON.
+// Query fields are kept in token to reject a token used with another query.
+type pageToken struct {
+	Kind       string `json:"k"`
+	SourceUid  string `json:"s,omitempty"`
+	Descending bool   `json:"d,omitempty"`
+	CreatedAt  int64  `json:"t,omitempty"` // unix milliseconds, as stored in database
+	ID         string `json:"id"`
+}
+
+const (
+	page_token_kind_sources  = "sources"
+	page_token_kind_messages = "messages"
+)
+
+func (token pageToken) Encode() string {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(s string, kind string) (pageToken, error) {
+	token := pageToken{}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, errors.Wrap(err, "page token is malformed")
+	}
+
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, errors.Wrap(err, "page token is malformed")
+	}
+
+	if token.Kind != kind || token.ID == "" {
+		return token, errors.New("page token is not valid for this request")
+	}
+
+	return token, nil
+}
+
+func newMessagesPageToken(query messagesQuery, last storedMessage) pageToken {
+	return pageToken{
+		Kind:       page_token_kind_messages,
+		SourceUid:  query.SourceUid,
+		Descending: query.Descending,
+		CreatedAt:  int64(last.MessageCreatedAt),
+		ID:         last.ID,
+	}
+}
+
+func (token pageToken) MessagesCursor(query messagesQuery) (*messagesCursor, error) {
+	if token.SourceUid != query.SourceUid || token.Descending != query.Descending {
+		return nil, errors.New("page token is not valid for this request")
+	}
+
+	return &messagesCursor{
+		CreatedAt: time.UnixMilli(token.CreatedAt).UTC(),
+		ID:        token.ID,
+	}, nil
+}
+
+func newSourcesPageToken(last storedSource) pageToken {
+	return pageToken{
+		Kind: page_token_kind_sources,
+		ID:   last.ID,
+	}
+}
+
+// Page size requested by client, limited by server maximum
+func pageSize(requested int32) int64 {
+	if requested <= 0 {
+		return rpc_page_size_default
+	}
+	if requested > rpc_page_size_max {
+		return rpc_page_size_max
+	}
+	return int64(requested)
+}
@@ -31,25 +31,61 @@ const (
 	FLAGS_User    FLAGS = 4
 	FLAGS_Group   FLAGS = 8
 	FLAGS_Channel FLAGS = 16
+	// FLO_MESSAGE is forwarded, see forward_from_source
+	FLAGS_ForwardFromSource FLAGS = 32
+	// FLO_SOURCE has a public username, see username
+	FLAGS_TgUsername FLAGS = 64 // TgMediaDownloadLinks = 128;
+	// FLO_MESSAGE was deleted in telegram, see deleted_at
+	FLAGS_Deleted FLAGS = 256
+	// FLO_MESSAGE is a service message (pin, title change, join, call, ...), see service_action
+	FLAGS_Service FLAGS = 512
+	// FLO_SOURCE telegram status, from telegram user or channel
+	FLAGS_Bot      FLAGS = 1024
+	FLAGS_Scam     FLAGS = 2048
+	FLAGS_Fake     FLAGS = 4096
+	FLAGS_Verified FLAGS = 8192
+	FLAGS_Premium  FLAGS = 16384
+	// FLO_SOURCE is a forum supergroup, messages are in topics (see topic_id)
+	FLAGS_Forum FLAGS = 32768
 )
 
 // Enum value maps for FLAGS.
 var (
 	FLAGS_name = map[int32]string{
-		0:  "Invalid",
-		1:  "V1",
-		2:  "Tg",
-		4:  "User",
-		8:  "Group",
-		16: "Channel",
+		0:     "Invalid",
+		1:     "V1",
+		2:     "Tg",
+		4:     "User",
+		8:     "Group",
+		16:    "Channel",
+		32:    "ForwardFromSource",
+		64:    "TgUsername",
+		256:   "Deleted",
+		512:   "Service",
+		1024:  "Bot",
+		2048:  "Scam",
+		4096:  "Fake",
+		8192:  "Verified",
+		16384: "Premium",
+		32768: "Forum",
 	}
 	FLAGS_value = map[string]int32{
-		"Invalid": 0,
-		"V1":      1,
-		"Tg":      2,
-		"User":    4,
-		"Group":   8,
-		"Channel": 16,
+		"Invalid":           0,
+		"V1":                1,
+		"Tg":                2,
+		"User":              4,
+		"Group":             8,
+		"Channel":           16,
+		"ForwardFromSource": 32,
+		"TgUsername":        64,
+		"Deleted":           256,
+		"Service":           512,
+		"Bot":               1024,
+		"Scam":              2048,
+		"Fake":              4096,
+		"Verified":          8192,
+		"Premium":           16384,
+		"Forum":             32768,
 	}
 )
 
@@ -80,6 +116,420 @@ func (FLAGS) EnumDescriptor() ([]byte, []int) {
 	return file_flogram_proto_rawDescGZIP(), []int{0}
 }
 
+type FLO_SERVICE_ACTION_TYPE int32
+
+const (
+	FLO_SERVICE_ACTION_TYPE_ActionUnknown             FLO_SERVICE_ACTION_TYPE = 0
+	FLO_SERVICE_ACTION_TYPE_ActionPin                 FLO_SERVICE_ACTION_TYPE = 1
+	FLO_SERVICE_ACTION_TYPE_ActionTitleChange         FLO_SERVICE_ACTION_TYPE = 2
+	FLO_SERVICE_ACTION_TYPE_ActionPhotoChange         FLO_SERVICE_ACTION_TYPE = 3
+	FLO_SERVICE_ACTION_TYPE_ActionPhotoDelete         FLO_SERVICE_ACTION_TYPE = 4
+	FLO_SERVICE_ACTION_TYPE_ActionMembersAdd          FLO_SERVICE_ACTION_TYPE = 5
+	FLO_SERVICE_ACTION_TYPE_ActionMemberJoinByLink    FLO_SERVICE_ACTION_TYPE = 6
+	FLO_SERVICE_ACTION_TYPE_ActionMemberJoinByRequest FLO_SERVICE_ACTION_TYPE = 7
+	FLO_SERVICE_ACTION_TYPE_ActionMemberLeave         FLO_SERVICE_ACTION_TYPE = 8
+	FLO_SERVICE_ACTION_TYPE_ActionGroupCallStart      FLO_SERVICE_ACTION_TYPE = 9
+	FLO_SERVICE_ACTION_TYPE_ActionGroupCallEnd        FLO_SERVICE_ACTION_TYPE = 10
+	FLO_SERVICE_ACTION_TYPE_ActionGroupCallScheduled  FLO_SERVICE_ACTION_TYPE = 11
+	FLO_SERVICE_ACTION_TYPE_ActionGroupCallInvite     FLO_SERVICE_ACTION_TYPE = 12
+	FLO_SERVICE_ACTION_TYPE_ActionCreate              FLO_SERVICE_ACTION_TYPE = 13
+	FLO_SERVICE_ACTION_TYPE_ActionMigrate             FLO_SERVICE_ACTION_TYPE = 14
+	FLO_SERVICE_ACTION_TYPE_ActionHistoryClear        FLO_SERVICE_ACTION_TYPE = 15
+	FLO_SERVICE_ACTION_TYPE_ActionPhoneCall           FLO_SERVICE_ACTION_TYPE = 16
+	FLO_SERVICE_ACTION_TYPE_ActionTopicCreate         FLO_SERVICE_ACTION_TYPE = 17
+	FLO_SERVICE_ACTION_TYPE_ActionTopicEdit           FLO_SERVICE_ACTION_TYPE = 18
+)
+
+// Enum value maps for FLO_SERVICE_ACTION_TYPE.
+var (
+	FLO_SERVICE_ACTION_TYPE_name = map[int32]string{
+		0:  "ActionUnknown",
+		1:  "ActionPin",
+		2:  "ActionTitleChange",
+		3:  "ActionPhotoChange",
+		4:  "ActionPhotoDelete",
+		5:  "ActionMembersAdd",
+		6:  "ActionMemberJoinByLink",
+		7:  "ActionMemberJoinByRequest",
+		8:  "ActionMemberLeave",
+		9:  "ActionGroupCallStart",
+		10: "ActionGroupCallEnd",
+		11: "ActionGroupCallScheduled",
+		12: "ActionGroupCallInvite",
+		13: "ActionCreate",
+		14: "ActionMigrate",
+		15: "ActionHistoryClear",
+		16: "ActionPhoneCall",
+		17: "ActionTopicCreate",
+		18: "ActionTopicEdit",
+	}
+	FLO_SERVICE_ACTION_TYPE_value = map[string]int32{
+		"ActionUnknown":             0,
+		"ActionPin":                 1,
+		"ActionTitleChange":         2,
+		"ActionPhotoChange":         3,
+		"ActionPhotoDelete":         4,
+		"ActionMembersAdd":          5,
+		"ActionMemberJoinByLink":    6,
+		"ActionMemberJoinByRequest": 7,
+		"ActionMemberLeave":         8,
+		"ActionGroupCallStart":      9,
+		"ActionGroupCallEnd":        10,
+		"ActionGroupCallScheduled":  11,
+		"ActionGroupCallInvite":     12,
+		"ActionCreate":              13,
+		"ActionMigrate":             14,
+		"ActionHistoryClear":        15,
+		"ActionPhoneCall":           16,
+		"ActionTopicCreate":         17,
+		"ActionTopicEdit":           18,
+	}
+)
+
+func (x FLO_SERVICE_ACTION_TYPE) Enum() *FLO_SERVICE_ACTION_TYPE {
+	p := new(FLO_SERVICE_ACTION_TYPE)
+	*p = x
+	return p
+}
+
+func (x FLO_SERVICE_ACTION_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FLO_SERVICE_ACTION_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_flogram_proto_enumTypes[1].Descriptor()
+}
+
+func (FLO_SERVICE_ACTION_TYPE) Type() protoreflect.EnumType {
+	return &file_flogram_proto_enumTypes[1]
+}
+
+func (x FLO_SERVICE_ACTION_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FLO_SERVICE_ACTION_TYPE.Descriptor instead.
+func (FLO_SERVICE_ACTION_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{1}
+}
+
+type FLO_ENTITY_TYPE int32
+
+const (
+	FLO_ENTITY_TYPE_EntityUnknown     FLO_ENTITY_TYPE = 0
+	FLO_ENTITY_TYPE_EntityBold        FLO_ENTITY_TYPE = 1
+	FLO_ENTITY_TYPE_EntityItalic      FLO_ENTITY_TYPE = 2
+	FLO_ENTITY_TYPE_EntityUnderline   FLO_ENTITY_TYPE = 3
+	FLO_ENTITY_TYPE_EntityStrike      FLO_ENTITY_TYPE = 4
+	FLO_ENTITY_TYPE_EntitySpoiler     FLO_ENTITY_TYPE = 5
+	FLO_ENTITY_TYPE_EntityCode        FLO_ENTITY_TYPE = 6
+	FLO_ENTITY_TYPE_EntityPre         FLO_ENTITY_TYPE = 7
+	FLO_ENTITY_TYPE_EntityBlockquote  FLO_ENTITY_TYPE = 8
+	FLO_ENTITY_TYPE_EntityTextUrl     FLO_ENTITY_TYPE = 9
+	FLO_ENTITY_TYPE_EntityUrl         FLO_ENTITY_TYPE = 10
+	FLO_ENTITY_TYPE_EntityEmail       FLO_ENTITY_TYPE = 11
+	FLO_ENTITY_TYPE_EntityPhone       FLO_ENTITY_TYPE = 12
+	FLO_ENTITY_TYPE_EntityMention     FLO_ENTITY_TYPE = 13
+	FLO_ENTITY_TYPE_EntityMentionName FLO_ENTITY_TYPE = 14
+	FLO_ENTITY_TYPE_EntityHashtag     FLO_ENTITY_TYPE = 15
+	FLO_ENTITY_TYPE_EntityCashtag     FLO_ENTITY_TYPE = 16
+	FLO_ENTITY_TYPE_EntityBotCommand  FLO_ENTITY_TYPE = 17
+	FLO_ENTITY_TYPE_EntityBankCard    FLO_ENTITY_TYPE = 18
+	FLO_ENTITY_TYPE_EntityCustomEmoji FLO_ENTITY_TYPE = 19
+)
+
+// Enum value maps for FLO_ENTITY_TYPE.
+var (
+	FLO_ENTITY_TYPE_name = map[int32]string{
+		0:  "EntityUnknown",
+		1:  "EntityBold",
+		2:  "EntityItalic",
+		3:  "EntityUnderline",
+		4:  "EntityStrike",
+		5:  "EntitySpoiler",
+		6:  "EntityCode",
+		7:  "EntityPre",
+		8:  "EntityBlockquote",
+		9:  "EntityTextUrl",
+		10: "EntityUrl",
+		11: "EntityEmail",
+		12: "EntityPhone",
+		13: "EntityMention",
+		14: "EntityMentionName",
+		15: "EntityHashtag",
+		16: "EntityCashtag",
+		17: "EntityBotCommand",
+		18: "EntityBankCard",
+		19: "EntityCustomEmoji",
+	}
+	FLO_ENTITY_TYPE_value = map[string]int32{
+		"EntityUnknown":     0,
+		"EntityBold":        1,
+		"EntityItalic":      2,
+		"EntityUnderline":   3,
+		"EntityStrike":      4,
+		"EntitySpoiler":     5,
+		"EntityCode":        6,
+		"EntityPre":         7,
+		"EntityBlockquote":  8,
+		"EntityTextUrl":     9,
+		"EntityUrl":         10,
+		"EntityEmail":       11,
+		"EntityPhone":       12,
+		"EntityMention":     13,
+		"EntityMentionName": 14,
+		"EntityHashtag":     15,
+		"EntityCashtag":     16,
+		"EntityBotCommand":  17,
+		"EntityBankCard":    18,
+		"EntityCustomEmoji": 19,
+	}
+)
+
+func (x FLO_ENTITY_TYPE) Enum() *FLO_ENTITY_TYPE {
+	p := new(FLO_ENTITY_TYPE)
+	*p = x
+	return p
+}
+
+func (x FLO_ENTITY_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FLO_ENTITY_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_flogram_proto_enumTypes[2].Descriptor()
+}
+
+func (FLO_ENTITY_TYPE) Type() protoreflect.EnumType {
+	return &file_flogram_proto_enumTypes[2]
+}
+
+func (x FLO_ENTITY_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FLO_ENTITY_TYPE.Descriptor instead.
+func (FLO_ENTITY_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{2}
+}
+
+type FLO_ATTACHMENT_TYPE int32
+
+const (
+	FLO_ATTACHMENT_TYPE_AttachmentUnknown  FLO_ATTACHMENT_TYPE = 0
+	FLO_ATTACHMENT_TYPE_AttachmentPhoto    FLO_ATTACHMENT_TYPE = 1
+	FLO_ATTACHMENT_TYPE_AttachmentDocument FLO_ATTACHMENT_TYPE = 2
+	FLO_ATTACHMENT_TYPE_AttachmentVideo    FLO_ATTACHMENT_TYPE = 3
+	FLO_ATTACHMENT_TYPE_AttachmentVoice    FLO_ATTACHMENT_TYPE = 4
+)
+
+// Enum value maps for FLO_ATTACHMENT_TYPE.
+var (
+	FLO_ATTACHMENT_TYPE_name = map[int32]string{
+		0: "AttachmentUnknown",
+		1: "AttachmentPhoto",
+		2: "AttachmentDocument",
+		3: "AttachmentVideo",
+		4: "AttachmentVoice",
+	}
+	FLO_ATTACHMENT_TYPE_value = map[string]int32{
+		"AttachmentUnknown":  0,
+		"AttachmentPhoto":    1,
+		"AttachmentDocument": 2,
+		"AttachmentVideo":    3,
+		"AttachmentVoice":    4,
+	}
+)
+
+func (x FLO_ATTACHMENT_TYPE) Enum() *FLO_ATTACHMENT_TYPE {
+	p := new(FLO_ATTACHMENT_TYPE)
+	*p = x
+	return p
+}
+
+func (x FLO_ATTACHMENT_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FLO_ATTACHMENT_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_flogram_proto_enumTypes[3].Descriptor()
+}
+
+func (FLO_ATTACHMENT_TYPE) Type() protoreflect.EnumType {
+	return &file_flogram_proto_enumTypes[3]
+}
+
+func (x FLO_ATTACHMENT_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FLO_ATTACHMENT_TYPE.Descriptor instead.
+func (FLO_ATTACHMENT_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{3}
+}
+
+type FlotgDeletedFilter int32
+
+const (
+	FlotgDeletedFilter_DeletedInclude FlotgDeletedFilter = 0
+	FlotgDeletedFilter_DeletedExclude FlotgDeletedFilter = 1
+	FlotgDeletedFilter_DeletedOnly    FlotgDeletedFilter = 2
+)
+
+// Enum value maps for FlotgDeletedFilter.
+var (
+	FlotgDeletedFilter_name = map[int32]string{
+		0: "DeletedInclude",
+		1: "DeletedExclude",
+		2: "DeletedOnly",
+	}
+	FlotgDeletedFilter_value = map[string]int32{
+		"DeletedInclude": 0,
+		"DeletedExclude": 1,
+		"DeletedOnly":    2,
+	}
+)
+
+func (x FlotgDeletedFilter) Enum() *FlotgDeletedFilter {
+	p := new(FlotgDeletedFilter)
+	*p = x
+	return p
+}
+
+func (x FlotgDeletedFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlotgDeletedFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_flogram_proto_enumTypes[4].Descriptor()
+}
+
+func (FlotgDeletedFilter) Type() protoreflect.EnumType {
+	return &file_flogram_proto_enumTypes[4]
+}
+
+func (x FlotgDeletedFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlotgDeletedFilter.Descriptor instead.
+func (FlotgDeletedFilter) EnumDescriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{4}
+}
+
+type FlotgAuthState int32
+
+const (
+	FlotgAuthState_AuthUnknown         FlotgAuthState = 0
+	FlotgAuthState_AuthChecking        FlotgAuthState = 1
+	FlotgAuthState_AuthWaitingBegin    FlotgAuthState = 2
+	FlotgAuthState_AuthSendingCode     FlotgAuthState = 3
+	FlotgAuthState_AuthWaitingCode     FlotgAuthState = 4
+	FlotgAuthState_AuthWaitingPassword FlotgAuthState = 5
+	FlotgAuthState_AuthSigningIn       FlotgAuthState = 6
+	FlotgAuthState_AuthAuthorized      FlotgAuthState = 7
+	FlotgAuthState_AuthFailed          FlotgAuthState = 8
+)
+
+// Enum value maps for FlotgAuthState.
+var (
+	FlotgAuthState_name = map[int32]string{
+		0: "AuthUnknown",
+		1: "AuthChecking",
+		2: "AuthWaitingBegin",
+		3: "AuthSendingCode",
+		4: "AuthWaitingCode",
+		5: "AuthWaitingPassword",
+		6: "AuthSigningIn",
+		7: "AuthAuthorized",
+		8: "AuthFailed",
+	}
+	FlotgAuthState_value = map[string]int32{
+		"AuthUnknown":         0,
+		"AuthChecking":        1,
+		"AuthWaitingBegin":    2,
+		"AuthSendingCode":     3,
+		"AuthWaitingCode":     4,
+		"AuthWaitingPassword": 5,
+		"AuthSigningIn":       6,
+		"AuthAuthorized":      7,
+		"AuthFailed":          8,
+	}
+)
+
+func (x FlotgAuthState) Enum() *FlotgAuthState {
+	p := new(FlotgAuthState)
+	*p = x
+	return p
+}
+
+func (x FlotgAuthState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlotgAuthState) Descriptor() protoreflect.EnumDescriptor {
+	return file_flogram_proto_enumTypes[5].Descriptor()
+}
+
+func (FlotgAuthState) Type() protoreflect.EnumType {
+	return &file_flogram_proto_enumTypes[5]
+}
+
+func (x FlotgAuthState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlotgAuthState.Descriptor instead.
+func (FlotgAuthState) EnumDescriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{5}
+}
+
+type FlotgSortOrder int32
+
+const (
+	FlotgSortOrder_SortAscending  FlotgSortOrder = 0
+	FlotgSortOrder_SortDescending FlotgSortOrder = 1
+)
+
+// Enum value maps for FlotgSortOrder.
+var (
+	FlotgSortOrder_name = map[int32]string{
+		0: "SortAscending",
+		1: "SortDescending",
+	}
+	FlotgSortOrder_value = map[string]int32{
+		"SortAscending":  0,
+		"SortDescending": 1,
+	}
+)
+
+func (x FlotgSortOrder) Enum() *FlotgSortOrder {
+	p := new(FlotgSortOrder)
+	*p = x
+	return p
+}
+
+func (x FlotgSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlotgSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_flogram_proto_enumTypes[6].Descriptor()
+}
+
+func (FlotgSortOrder) Type() protoreflect.EnumType {
+	return &file_flogram_proto_enumTypes[6]
+}
+
+func (x FlotgSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlotgSortOrder.Descriptor instead.
+func (FlotgSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{6}
+}
+
 type FLO_SOURCE struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -88,6 +538,12 @@ type FLO_SOURCE struct {
 	Flags     int32  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
 	SourceUid string `protobuf:"bytes,2,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
 	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// New messages are saved/streamed only for monitored sources
+	Monitored bool `protobuf:"varint,4,opt,name=monitored,proto3" json:"monitored,omitempty"`
+	// Public telegram username without "@" (TgUsername flag is set), https://t.me/<username>
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Past titles, oldest first. Only set on request.
+	TitleHistory []*FLO_SOURCE_TITLE `protobuf:"bytes,6,rep,name=title_history,json=titleHistory,proto3" json:"title_history,omitempty"`
 }
 
 func (x *FLO_SOURCE) Reset() {
@@ -143,6 +599,91 @@ func (x *FLO_SOURCE) GetTitle() string {
 	return ""
 }
 
+func (x *FLO_SOURCE) GetMonitored() bool {
+	if x != nil {
+		return x.Monitored
+	}
+	return false
+}
+
+func (x *FLO_SOURCE) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FLO_SOURCE) GetTitleHistory() []*FLO_SOURCE_TITLE {
+	if x != nil {
+		return x.TitleHistory
+	}
+	return nil
+}
+
+type FLO_SOURCE_TITLE struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Time the title was first seen, and the time it was changed
+	Since *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *FLO_SOURCE_TITLE) Reset() {
+	*x = FLO_SOURCE_TITLE{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FLO_SOURCE_TITLE) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FLO_SOURCE_TITLE) ProtoMessage() {}
+
+func (x *FLO_SOURCE_TITLE) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FLO_SOURCE_TITLE.ProtoReflect.Descriptor instead.
+func (*FLO_SOURCE_TITLE) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FLO_SOURCE_TITLE) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FLO_SOURCE_TITLE) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *FLO_SOURCE_TITLE) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
 type FLO_MESSAGE struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -155,12 +696,40 @@ type FLO_MESSAGE struct {
 	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Text         string                 `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
 	MessageLinks []string               `protobuf:"bytes,8,rep,name=message_links,json=messageLinks,proto3" json:"message_links,omitempty"`
+	// Origin of forwarded message (ForwardFromSource flag is set).
+	// Origin not known to flo_tg may have no title, origin hidden by privacy settings has title only.
+	ForwardFromSource *FLO_SOURCE `protobuf:"bytes,9,opt,name=forward_from_source,json=forwardFromSource,proto3" json:"forward_from_source,omitempty"`
+	// Time of the last edit, unset if message was never edited
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	// All versions of the message, oldest first (including current). Only set on request.
+	Revisions []*FLO_MESSAGE_REVISION `protobuf:"bytes,11,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Time the message was deleted in telegram (Deleted flag is set), message is kept in storage
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Original message id and date of forwarded message, id is only known for channel posts
+	ForwardFromMessageId int32                  `protobuf:"varint,13,opt,name=forward_from_message_id,json=forwardFromMessageId,proto3" json:"forward_from_message_id,omitempty"`
+	ForwardFromCreatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=forward_from_created_at,json=forwardFromCreatedAt,proto3" json:"forward_from_created_at,omitempty"`
+	// Media of the message, files are downloaded in background (see GetAttachment)
+	Attachments []*FLO_ATTACHMENT `protobuf:"bytes,15,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Formatting of text (bold, links, code, mentions, ...)
+	Entities []*FLO_ENTITY `protobuf:"bytes,16,rep,name=entities,proto3" json:"entities,omitempty"`
+	// Text rendered with entities, only set on request
+	TextMarkdown string `protobuf:"bytes,17,opt,name=text_markdown,json=textMarkdown,proto3" json:"text_markdown,omitempty"`
+	TextHtml     string `protobuf:"bytes,18,opt,name=text_html,json=textHtml,proto3" json:"text_html,omitempty"`
+	// Event of service message (Service flag is set), text is a short description of the event
+	ServiceAction *FLO_SERVICE_ACTION `protobuf:"bytes,19,opt,name=service_action,json=serviceAction,proto3" json:"service_action,omitempty"`
+	// Replied message (may be of another source), and the first message of the reply thread
+	ReplyToMessageUid    string `protobuf:"bytes,20,opt,name=reply_to_message_uid,json=replyToMessageUid,proto3" json:"reply_to_message_uid,omitempty"`
+	ReplyToTopMessageUid string `protobuf:"bytes,21,opt,name=reply_to_top_message_uid,json=replyToTopMessageUid,proto3" json:"reply_to_top_message_uid,omitempty"`
+	// Forum topic (id of topic first message), zero when message is not in a topic or in General topic
+	TopicId int32 `protobuf:"varint,22,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// Sender in groups and private chats, signature of channel posts. Unset when neither is known.
+	Author *FLO_AUTHOR `protobuf:"bytes,23,opt,name=author,proto3" json:"author,omitempty"`
 }
 
 func (x *FLO_MESSAGE) Reset() {
 	*x = FLO_MESSAGE{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flogram_proto_msgTypes[1]
+		mi := &file_flogram_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -173,7 +742,7 @@ func (x *FLO_MESSAGE) String() string {
 func (*FLO_MESSAGE) ProtoMessage() {}
 
 func (x *FLO_MESSAGE) ProtoReflect() protoreflect.Message {
-	mi := &file_flogram_proto_msgTypes[1]
+	mi := &file_flogram_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +755,7 @@ func (x *FLO_MESSAGE) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FLO_MESSAGE.ProtoReflect.Descriptor instead.
 func (*FLO_MESSAGE) Descriptor() ([]byte, []int) {
-	return file_flogram_proto_rawDescGZIP(), []int{1}
+	return file_flogram_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *FLO_MESSAGE) GetFlags() int32 {
@@ -238,33 +807,1301 @@ func (x *FLO_MESSAGE) GetMessageLinks() []string {
 	return nil
 }
 
-type FlotgGetSourcesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FLO_MESSAGE) GetForwardFromSource() *FLO_SOURCE {
+	if x != nil {
+		return x.ForwardFromSource
+	}
+	return nil
+}
 
-	Flags       int32    `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	SourceUids  []string `protobuf:"bytes,2,rep,name=source_uids,json=sourceUids,proto3" json:"source_uids,omitempty"`
-	FilterFlags []int32  `protobuf:"varint,3,rep,packed,name=filter_flags,json=filterFlags,proto3" json:"filter_flags,omitempty"`
+func (x *FLO_MESSAGE) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
 }
 
-func (x *FlotgGetSourcesRequest) Reset() {
-	*x = FlotgGetSourcesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flogram_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FLO_MESSAGE) GetRevisions() []*FLO_MESSAGE_REVISION {
+	if x != nil {
+		return x.Revisions
 	}
+	return nil
 }
 
-func (x *FlotgGetSourcesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FLO_MESSAGE) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
 }
 
-func (*FlotgGetSourcesRequest) ProtoMessage() {}
+func (x *FLO_MESSAGE) GetForwardFromMessageId() int32 {
+	if x != nil {
+		return x.ForwardFromMessageId
+	}
+	return 0
+}
 
-func (x *FlotgGetSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flogram_proto_msgTypes[2]
+func (x *FLO_MESSAGE) GetForwardFromCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ForwardFromCreatedAt
+	}
+	return nil
+}
+
+func (x *FLO_MESSAGE) GetAttachments() []*FLO_ATTACHMENT {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *FLO_MESSAGE) GetEntities() []*FLO_ENTITY {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *FLO_MESSAGE) GetTextMarkdown() string {
+	if x != nil {
+		return x.TextMarkdown
+	}
+	return ""
+}
+
+func (x *FLO_MESSAGE) GetTextHtml() string {
+	if x != nil {
+		return x.TextHtml
+	}
+	return ""
+}
+
+func (x *FLO_MESSAGE) GetServiceAction() *FLO_SERVICE_ACTION {
+	if x != nil {
+		return x.ServiceAction
+	}
+	return nil
+}
+
+func (x *FLO_MESSAGE) GetReplyToMessageUid() string {
+	if x != nil {
+		return x.ReplyToMessageUid
+	}
+	return ""
+}
+
+func (x *FLO_MESSAGE) GetReplyToTopMessageUid() string {
+	if x != nil {
+		return x.ReplyToTopMessageUid
+	}
+	return ""
+}
+
+func (x *FLO_MESSAGE) GetTopicId() int32 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *FLO_MESSAGE) GetAuthor() *FLO_AUTHOR {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type FLO_AUTHOR struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source uid of sender user, or of channel when message is sent on behalf of a channel
+	SourceUid string `protobuf:"bytes,1,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
+	// Only set for user senders
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Name of user or title of channel, and public username (without "@") if any
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Username    string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// Post author signature of signed channel posts
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *FLO_AUTHOR) Reset() {
+	*x = FLO_AUTHOR{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FLO_AUTHOR) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FLO_AUTHOR) ProtoMessage() {}
+
+func (x *FLO_AUTHOR) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FLO_AUTHOR.ProtoReflect.Descriptor instead.
+func (*FLO_AUTHOR) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FLO_AUTHOR) GetSourceUid() string {
+	if x != nil {
+		return x.SourceUid
+	}
+	return ""
+}
+
+func (x *FLO_AUTHOR) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FLO_AUTHOR) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *FLO_AUTHOR) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FLO_AUTHOR) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type FLO_SERVICE_ACTION struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FLO_SERVICE_ACTION_TYPE `protobuf:"varint,1,opt,name=type,proto3,enum=FLO_SERVICE_ACTION_TYPE" json:"type,omitempty"`
+	// New title of group, channel or topic
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Telegram ids of users added, joined, left or invited
+	UserIds []int64 `protobuf:"varint,3,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// ActionPin: the pinned message
+	PinnedMessageUid string `protobuf:"bytes,4,opt,name=pinned_message_uid,json=pinnedMessageUid,proto3" json:"pinned_message_uid,omitempty"`
+	// ActionGroupCallEnd, ActionPhoneCall: duration in seconds
+	Duration int32 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// ActionGroupCallScheduled: planned start of the call
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+}
+
+func (x *FLO_SERVICE_ACTION) Reset() {
+	*x = FLO_SERVICE_ACTION{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FLO_SERVICE_ACTION) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FLO_SERVICE_ACTION) ProtoMessage() {}
+
+func (x *FLO_SERVICE_ACTION) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FLO_SERVICE_ACTION.ProtoReflect.Descriptor instead.
+func (*FLO_SERVICE_ACTION) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FLO_SERVICE_ACTION) GetType() FLO_SERVICE_ACTION_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return FLO_SERVICE_ACTION_TYPE_ActionUnknown
+}
+
+func (x *FLO_SERVICE_ACTION) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FLO_SERVICE_ACTION) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *FLO_SERVICE_ACTION) GetPinnedMessageUid() string {
+	if x != nil {
+		return x.PinnedMessageUid
+	}
+	return ""
+}
+
+func (x *FLO_SERVICE_ACTION) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *FLO_SERVICE_ACTION) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type FLO_ENTITY struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FLO_ENTITY_TYPE `protobuf:"varint,1,opt,name=type,proto3,enum=FLO_ENTITY_TYPE" json:"type,omitempty"`
+	// Position in text, in UTF-16 code units
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length int32 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// EntityTextUrl: link target
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// EntityPre: programming language of code block
+	Language string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	// EntityMentionName: mentioned user id
+	UserId int64 `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// EntityCustomEmoji: emoji document id
+	DocumentId int64 `protobuf:"varint,7,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+}
+
+func (x *FLO_ENTITY) Reset() {
+	*x = FLO_ENTITY{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FLO_ENTITY) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FLO_ENTITY) ProtoMessage() {}
+
+func (x *FLO_ENTITY) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FLO_ENTITY.ProtoReflect.Descriptor instead.
+func (*FLO_ENTITY) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FLO_ENTITY) GetType() FLO_ENTITY_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return FLO_ENTITY_TYPE_EntityUnknown
+}
+
+func (x *FLO_ENTITY) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FLO_ENTITY) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *FLO_ENTITY) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FLO_ENTITY) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *FLO_ENTITY) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FLO_ENTITY) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+type FLO_ATTACHMENT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Same media forwarded or posted again has the same uid
+	AttachmentUid string              `protobuf:"bytes,1,opt,name=attachment_uid,json=attachmentUid,proto3" json:"attachment_uid,omitempty"`
+	Type          FLO_ATTACHMENT_TYPE `protobuf:"varint,2,opt,name=type,proto3,enum=FLO_ATTACHMENT_TYPE" json:"type,omitempty"`
+	MimeType      string              `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64               `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Only set for documents with a file name
+	FileName string `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Only set for photos, videos and images
+	Width  int32 `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *FLO_ATTACHMENT) Reset() {
+	*x = FLO_ATTACHMENT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FLO_ATTACHMENT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FLO_ATTACHMENT) ProtoMessage() {}
+
+func (x *FLO_ATTACHMENT) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FLO_ATTACHMENT.ProtoReflect.Descriptor instead.
+func (*FLO_ATTACHMENT) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FLO_ATTACHMENT) GetAttachmentUid() string {
+	if x != nil {
+		return x.AttachmentUid
+	}
+	return ""
+}
+
+func (x *FLO_ATTACHMENT) GetType() FLO_ATTACHMENT_TYPE {
+	if x != nil {
+		return x.Type
+	}
+	return FLO_ATTACHMENT_TYPE_AttachmentUnknown
+}
+
+func (x *FLO_ATTACHMENT) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *FLO_ATTACHMENT) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FLO_ATTACHMENT) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FLO_ATTACHMENT) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *FLO_ATTACHMENT) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type FLO_MESSAGE_REVISION struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unset for the original version
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Text     string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *FLO_MESSAGE_REVISION) Reset() {
+	*x = FLO_MESSAGE_REVISION{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FLO_MESSAGE_REVISION) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FLO_MESSAGE_REVISION) ProtoMessage() {}
+
+func (x *FLO_MESSAGE_REVISION) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FLO_MESSAGE_REVISION.ProtoReflect.Descriptor instead.
+func (*FLO_MESSAGE_REVISION) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FLO_MESSAGE_REVISION) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *FLO_MESSAGE_REVISION) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Status of flo_tg components, also available with grpc.health.v1 service (component name as service name)
+// Ready fails with Unavailable status when not ready, with this response in status details
+type FlotgReadyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ready      bool                    `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Components []*FlotgComponentStatus `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+}
+
+func (x *FlotgReadyResponse) Reset() {
+	*x = FlotgReadyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgReadyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgReadyResponse) ProtoMessage() {}
+
+func (x *FlotgReadyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgReadyResponse.ProtoReflect.Descriptor instead.
+func (*FlotgReadyResponse) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FlotgReadyResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *FlotgReadyResponse) GetComponents() []*FlotgComponentStatus {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type FlotgComponentStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Serving   bool                   `protobuf:"varint,2,opt,name=serving,proto3" json:"serving,omitempty"`
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *FlotgComponentStatus) Reset() {
+	*x = FlotgComponentStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgComponentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgComponentStatus) ProtoMessage() {}
+
+func (x *FlotgComponentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgComponentStatus.ProtoReflect.Descriptor instead.
+func (*FlotgComponentStatus) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FlotgComponentStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlotgComponentStatus) GetServing() bool {
+	if x != nil {
+		return x.Serving
+	}
+	return false
+}
+
+func (x *FlotgComponentStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FlotgComponentStatus) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FlotgSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     int32  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SourceUid string `protobuf:"bytes,2,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
+}
+
+func (x *FlotgSourceRequest) Reset() {
+	*x = FlotgSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgSourceRequest) ProtoMessage() {}
+
+func (x *FlotgSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgSourceRequest.ProtoReflect.Descriptor instead.
+func (*FlotgSourceRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FlotgSourceRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgSourceRequest) GetSourceUid() string {
+	if x != nil {
+		return x.SourceUid
+	}
+	return ""
+}
+
+type FlotgGetSourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags       int32    `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SourceUids  []string `protobuf:"bytes,2,rep,name=source_uids,json=sourceUids,proto3" json:"source_uids,omitempty"`
+	FilterFlags []int32  `protobuf:"varint,3,rep,packed,name=filter_flags,json=filterFlags,proto3" json:"filter_flags,omitempty"`
+	// Opaque token from "next-page-token" trailer of the previous response
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Maximum number of sources in a page, zero means server default.
+	PageSize      int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	MonitoredOnly bool  `protobuf:"varint,6,opt,name=monitored_only,json=monitoredOnly,proto3" json:"monitored_only,omitempty"`
+	// Fill title_history of sources
+	IncludeTitleHistory bool `protobuf:"varint,7,opt,name=include_title_history,json=includeTitleHistory,proto3" json:"include_title_history,omitempty"`
+}
+
+func (x *FlotgGetSourcesRequest) Reset() {
+	*x = FlotgGetSourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgGetSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgGetSourcesRequest) ProtoMessage() {}
+
+func (x *FlotgGetSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgGetSourcesRequest.ProtoReflect.Descriptor instead.
+func (*FlotgGetSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FlotgGetSourcesRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgGetSourcesRequest) GetSourceUids() []string {
+	if x != nil {
+		return x.SourceUids
+	}
+	return nil
+}
+
+func (x *FlotgGetSourcesRequest) GetFilterFlags() []int32 {
+	if x != nil {
+		return x.FilterFlags
+	}
+	return nil
+}
+
+func (x *FlotgGetSourcesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *FlotgGetSourcesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FlotgGetSourcesRequest) GetMonitoredOnly() bool {
+	if x != nil {
+		return x.MonitoredOnly
+	}
+	return false
+}
+
+func (x *FlotgGetSourcesRequest) GetIncludeTitleHistory() bool {
+	if x != nil {
+		return x.IncludeTitleHistory
+	}
+	return false
+}
+
+type FlotgGetMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags       int32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SourceUid   string  `protobuf:"bytes,2,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
+	FilterFlags []int32 `protobuf:"varint,3,rep,packed,name=filter_flags,json=filterFlags,proto3" json:"filter_flags,omitempty"`
+	// Time range on message creation time: since is inclusive, before is exclusive. Unset means unbounded.
+	MessagesSince  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=messages_since,json=messagesSince,proto3" json:"messages_since,omitempty"`
+	MessagesBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=messages_before,json=messagesBefore,proto3" json:"messages_before,omitempty"`
+	// Maximum number of messages returned, zero for no limit.
+	MaxCount  int32          `protobuf:"varint,6,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	SortOrder FlotgSortOrder `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3,enum=FlotgSortOrder" json:"sort_order,omitempty"`
+	// Opaque token from "next-page-token" trailer of the previous response
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Fill revisions of edited messages
+	IncludeRevisions bool `protobuf:"varint,9,opt,name=include_revisions,json=includeRevisions,proto3" json:"include_revisions,omitempty"`
+	// Deleted messages are included by default
+	Deleted FlotgDeletedFilter `protobuf:"varint,10,opt,name=deleted,proto3,enum=FlotgDeletedFilter" json:"deleted,omitempty"`
+	// Fill text_markdown and text_html of messages
+	IncludeMarkdown bool `protobuf:"varint,11,opt,name=include_markdown,json=includeMarkdown,proto3" json:"include_markdown,omitempty"`
+	IncludeHtml     bool `protobuf:"varint,12,opt,name=include_html,json=includeHtml,proto3" json:"include_html,omitempty"`
+	// Only messages of this forum topic, zero for all messages. General topic is 1.
+	TopicId int32 `protobuf:"varint,13,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// Only messages of this author (author source_uid), empty for all
+	AuthorUid string `protobuf:"bytes,14,opt,name=author_uid,json=authorUid,proto3" json:"author_uid,omitempty"`
+	// Maximum number of messages in a page, "next-page-token" trailer is set when more messages follow.
+	// Zero means all messages are returned (up to max_count) without a page token.
+	PageSize int32 `protobuf:"varint,15,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *FlotgGetMessagesRequest) Reset() {
+	*x = FlotgGetMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgGetMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgGetMessagesRequest) ProtoMessage() {}
+
+func (x *FlotgGetMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgGetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*FlotgGetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FlotgGetMessagesRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgGetMessagesRequest) GetSourceUid() string {
+	if x != nil {
+		return x.SourceUid
+	}
+	return ""
+}
+
+func (x *FlotgGetMessagesRequest) GetFilterFlags() []int32 {
+	if x != nil {
+		return x.FilterFlags
+	}
+	return nil
+}
+
+func (x *FlotgGetMessagesRequest) GetMessagesSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MessagesSince
+	}
+	return nil
+}
+
+func (x *FlotgGetMessagesRequest) GetMessagesBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MessagesBefore
+	}
+	return nil
+}
+
+func (x *FlotgGetMessagesRequest) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *FlotgGetMessagesRequest) GetSortOrder() FlotgSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return FlotgSortOrder_SortAscending
+}
+
+func (x *FlotgGetMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *FlotgGetMessagesRequest) GetIncludeRevisions() bool {
+	if x != nil {
+		return x.IncludeRevisions
+	}
+	return false
+}
+
+func (x *FlotgGetMessagesRequest) GetDeleted() FlotgDeletedFilter {
+	if x != nil {
+		return x.Deleted
+	}
+	return FlotgDeletedFilter_DeletedInclude
+}
+
+func (x *FlotgGetMessagesRequest) GetIncludeMarkdown() bool {
+	if x != nil {
+		return x.IncludeMarkdown
+	}
+	return false
+}
+
+func (x *FlotgGetMessagesRequest) GetIncludeHtml() bool {
+	if x != nil {
+		return x.IncludeHtml
+	}
+	return false
+}
+
+func (x *FlotgGetMessagesRequest) GetTopicId() int32 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *FlotgGetMessagesRequest) GetAuthorUid() string {
+	if x != nil {
+		return x.AuthorUid
+	}
+	return ""
+}
+
+func (x *FlotgGetMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type FlotgStreamMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags int32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Sources to receive new messages from, empty for all monitored sources
+	SourceUids []string `protobuf:"bytes,2,rep,name=source_uids,json=sourceUids,proto3" json:"source_uids,omitempty"`
+	// When set, stored messages since this time are sent first, then new messages follow
+	ReplaySince *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=replay_since,json=replaySince,proto3" json:"replay_since,omitempty"`
+}
+
+func (x *FlotgStreamMessagesRequest) Reset() {
+	*x = FlotgStreamMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgStreamMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgStreamMessagesRequest) ProtoMessage() {}
+
+func (x *FlotgStreamMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgStreamMessagesRequest.ProtoReflect.Descriptor instead.
+func (*FlotgStreamMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FlotgStreamMessagesRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgStreamMessagesRequest) GetSourceUids() []string {
+	if x != nil {
+		return x.SourceUids
+	}
+	return nil
+}
+
+func (x *FlotgStreamMessagesRequest) GetReplaySince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplaySince
+	}
+	return nil
+}
+
+// Backfill saves history of a monitored source, older than messages saved since flo_tg started.
+// Progress is saved, so an interrupted backfill continues where it stopped.
+type FlotgBackfillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     int32  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SourceUid string `protobuf:"bytes,2,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
+	// Stop after this number of messages is processed, zero for the whole history
+	MaxMessages int32 `protobuf:"varint,3,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	// Start again from the newest message, ignoring saved progress
+	Restart bool `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
+}
+
+func (x *FlotgBackfillRequest) Reset() {
+	*x = FlotgBackfillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgBackfillRequest) ProtoMessage() {}
+
+func (x *FlotgBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgBackfillRequest.ProtoReflect.Descriptor instead.
+func (*FlotgBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FlotgBackfillRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgBackfillRequest) GetSourceUid() string {
+	if x != nil {
+		return x.SourceUid
+	}
+	return ""
+}
+
+func (x *FlotgBackfillRequest) GetMaxMessages() int32 {
+	if x != nil {
+		return x.MaxMessages
+	}
+	return 0
+}
+
+func (x *FlotgBackfillRequest) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+type FlotgBackfillProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     int32  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SourceUid string `protobuf:"bytes,2,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
+	Processed int32  `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"`
+	Total     int32  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Backfill continues before this message id
+	OffsetMessageId int64 `protobuf:"varint,5,opt,name=offset_message_id,json=offsetMessageId,proto3" json:"offset_message_id,omitempty"`
+	Done            bool  `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *FlotgBackfillProgress) Reset() {
+	*x = FlotgBackfillProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgBackfillProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgBackfillProgress) ProtoMessage() {}
+
+func (x *FlotgBackfillProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgBackfillProgress.ProtoReflect.Descriptor instead.
+func (*FlotgBackfillProgress) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FlotgBackfillProgress) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgBackfillProgress) GetSourceUid() string {
+	if x != nil {
+		return x.SourceUid
+	}
+	return ""
+}
+
+func (x *FlotgBackfillProgress) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *FlotgBackfillProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FlotgBackfillProgress) GetOffsetMessageId() int64 {
+	if x != nil {
+		return x.OffsetMessageId
+	}
+	return 0
+}
+
+func (x *FlotgBackfillProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// Dialogs of the telegram account, listed sources are saved so monitoring can be turned on
+type FlotgListDialogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags       int32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	FilterFlags []int32 `protobuf:"varint,2,rep,packed,name=filter_flags,json=filterFlags,proto3" json:"filter_flags,omitempty"`
+}
+
+func (x *FlotgListDialogsRequest) Reset() {
+	*x = FlotgListDialogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgListDialogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgListDialogsRequest) ProtoMessage() {}
+
+func (x *FlotgListDialogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgListDialogsRequest.ProtoReflect.Descriptor instead.
+func (*FlotgListDialogsRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FlotgListDialogsRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgListDialogsRequest) GetFilterFlags() []int32 {
+	if x != nil {
+		return x.FilterFlags
+	}
+	return nil
+}
+
+type FlotgDialog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source        *FLO_SOURCE            `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	LastMessageAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`
+}
+
+func (x *FlotgDialog) Reset() {
+	*x = FlotgDialog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgDialog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgDialog) ProtoMessage() {}
+
+func (x *FlotgDialog) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,59 +2112,431 @@ func (x *FlotgGetSourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlotgGetSourcesRequest.ProtoReflect.Descriptor instead.
-func (*FlotgGetSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_flogram_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use FlotgDialog.ProtoReflect.Descriptor instead.
+func (*FlotgDialog) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FlotgGetSourcesRequest) GetFlags() int32 {
+func (x *FlotgDialog) GetSource() *FLO_SOURCE {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *FlotgDialog) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *FlotgDialog) GetLastMessageAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return nil
+}
+
+type FlotgAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags         int32  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	AttachmentUid string `protobuf:"bytes,2,opt,name=attachment_uid,json=attachmentUid,proto3" json:"attachment_uid,omitempty"`
+}
+
+func (x *FlotgAttachmentRequest) Reset() {
+	*x = FlotgAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgAttachmentRequest) ProtoMessage() {}
+
+func (x *FlotgAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*FlotgAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FlotgAttachmentRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgAttachmentRequest) GetAttachmentUid() string {
+	if x != nil {
+		return x.AttachmentUid
+	}
+	return ""
+}
+
+// Attachment file is streamed in chunks, in order
+type FlotgAttachmentChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// File size, same in all chunks
+	Size   int64  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FlotgAttachmentChunk) Reset() {
+	*x = FlotgAttachmentChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgAttachmentChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgAttachmentChunk) ProtoMessage() {}
+
+func (x *FlotgAttachmentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgAttachmentChunk.ProtoReflect.Descriptor instead.
+func (*FlotgAttachmentChunk) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FlotgAttachmentChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FlotgAttachmentChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FlotgAttachmentChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Thread of a stored message: ancestors (root first), the message, then replies (oldest first)
+type FlotgThreadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags      int32  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SourceUid  string `protobuf:"bytes,2,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
+	MessageUid string `protobuf:"bytes,3,opt,name=message_uid,json=messageUid,proto3" json:"message_uid,omitempty"`
+	// Maximum number of replies, zero means server default
+	MaxReplies int32 `protobuf:"varint,4,opt,name=max_replies,json=maxReplies,proto3" json:"max_replies,omitempty"`
+}
+
+func (x *FlotgThreadRequest) Reset() {
+	*x = FlotgThreadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgThreadRequest) ProtoMessage() {}
+
+func (x *FlotgThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgThreadRequest.ProtoReflect.Descriptor instead.
+func (*FlotgThreadRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FlotgThreadRequest) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *FlotgThreadRequest) GetSourceUid() string {
+	if x != nil {
+		return x.SourceUid
+	}
+	return ""
+}
+
+func (x *FlotgThreadRequest) GetMessageUid() string {
+	if x != nil {
+		return x.MessageUid
+	}
+	return ""
+}
+
+func (x *FlotgThreadRequest) GetMaxReplies() int32 {
+	if x != nil {
+		return x.MaxReplies
+	}
+	return 0
+}
+
+// Forum topic of a supergroup, listed with Telegram API
+type FlotgTopic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceUid     string                 `protobuf:"bytes,1,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
+	TopicId       int32                  `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Closed        bool                   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	Pinned        bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Hidden        bool                   `protobuf:"varint,7,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	TopMessageUid string                 `protobuf:"bytes,8,opt,name=top_message_uid,json=topMessageUid,proto3" json:"top_message_uid,omitempty"`
+}
+
+func (x *FlotgTopic) Reset() {
+	*x = FlotgTopic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgTopic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgTopic) ProtoMessage() {}
+
+func (x *FlotgTopic) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgTopic.ProtoReflect.Descriptor instead.
+func (*FlotgTopic) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FlotgTopic) GetSourceUid() string {
+	if x != nil {
+		return x.SourceUid
+	}
+	return ""
+}
+
+func (x *FlotgTopic) GetTopicId() int32 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *FlotgTopic) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FlotgTopic) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FlotgTopic) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *FlotgTopic) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *FlotgTopic) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *FlotgTopic) GetTopMessageUid() string {
+	if x != nil {
+		return x.TopMessageUid
+	}
+	return ""
+}
+
+type FlotgAuthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags int32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	// AuthBegin: optional, must be the same number as TG_PHONE
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	// AuthSubmitCode: login code sent by Telegram
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// AuthSubmitPassword: 2FA password
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *FlotgAuthRequest) Reset() {
+	*x = FlotgAuthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flogram_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlotgAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlotgAuthRequest) ProtoMessage() {}
+
+func (x *FlotgAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlotgAuthRequest.ProtoReflect.Descriptor instead.
+func (*FlotgAuthRequest) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FlotgAuthRequest) GetFlags() int32 {
 	if x != nil {
 		return x.Flags
 	}
 	return 0
 }
 
-func (x *FlotgGetSourcesRequest) GetSourceUids() []string {
+func (x *FlotgAuthRequest) GetPhone() string {
 	if x != nil {
-		return x.SourceUids
+		return x.Phone
 	}
-	return nil
+	return ""
 }
 
-func (x *FlotgGetSourcesRequest) GetFilterFlags() []int32 {
+func (x *FlotgAuthRequest) GetCode() string {
 	if x != nil {
-		return x.FilterFlags
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type FlotgGetMessagesRequest struct {
+func (x *FlotgAuthRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type FlotgAuthStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags       int32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	SourceUid   string  `protobuf:"bytes,2,opt,name=source_uid,json=sourceUid,proto3" json:"source_uid,omitempty"`
-	FilterFlags []int32 `protobuf:"varint,3,rep,packed,name=filter_flags,json=filterFlags,proto3" json:"filter_flags,omitempty"`
+	State FlotgAuthState `protobuf:"varint,1,opt,name=state,proto3,enum=FlotgAuthState" json:"state,omitempty"`
+	// Error of the last failed login attempt
+	Error           string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	RpcLoginEnabled bool   `protobuf:"varint,3,opt,name=rpc_login_enabled,json=rpcLoginEnabled,proto3" json:"rpc_login_enabled,omitempty"`
 }
 
-func (x *FlotgGetMessagesRequest) Reset() {
-	*x = FlotgGetMessagesRequest{}
+func (x *FlotgAuthStatus) Reset() {
+	*x = FlotgAuthStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flogram_proto_msgTypes[3]
+		mi := &file_flogram_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FlotgGetMessagesRequest) String() string {
+func (x *FlotgAuthStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlotgGetMessagesRequest) ProtoMessage() {}
+func (*FlotgAuthStatus) ProtoMessage() {}
 
-func (x *FlotgGetMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flogram_proto_msgTypes[3]
+func (x *FlotgAuthStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flogram_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,30 +2547,30 @@ func (x *FlotgGetMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlotgGetMessagesRequest.ProtoReflect.Descriptor instead.
-func (*FlotgGetMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_flogram_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use FlotgAuthStatus.ProtoReflect.Descriptor instead.
+func (*FlotgAuthStatus) Descriptor() ([]byte, []int) {
+	return file_flogram_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *FlotgGetMessagesRequest) GetFlags() int32 {
+func (x *FlotgAuthStatus) GetState() FlotgAuthState {
 	if x != nil {
-		return x.Flags
+		return x.State
 	}
-	return 0
+	return FlotgAuthState_AuthUnknown
 }
 
-func (x *FlotgGetMessagesRequest) GetSourceUid() string {
+func (x *FlotgAuthStatus) GetError() string {
 	if x != nil {
-		return x.SourceUid
+		return x.Error
 	}
 	return ""
 }
 
-func (x *FlotgGetMessagesRequest) GetFilterFlags() []int32 {
+func (x *FlotgAuthStatus) GetRpcLoginEnabled() bool {
 	if x != nil {
-		return x.FilterFlags
+		return x.RpcLoginEnabled
 	}
-	return nil
+	return false
 }
 
 type FloRssFeed struct {
@@ -378,7 +2587,7 @@ type FloRssFeed struct {
 func (x *FloRssFeed) Reset() {
 	*x = FloRssFeed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flogram_proto_msgTypes[4]
+		mi := &file_flogram_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -391,7 +2600,7 @@ func (x *FloRssFeed) String() string {
 func (*FloRssFeed) ProtoMessage() {}
 
 func (x *FloRssFeed) ProtoReflect() protoreflect.Message {
-	mi := &file_flogram_proto_msgTypes[4]
+	mi := &file_flogram_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +2613,7 @@ func (x *FloRssFeed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloRssFeed.ProtoReflect.Descriptor instead.
 func (*FloRssFeed) Descriptor() ([]byte, []int) {
-	return file_flogram_proto_rawDescGZIP(), []int{4}
+	return file_flogram_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *FloRssFeed) GetFlags() int32 {
@@ -447,7 +2656,7 @@ type FloRssCreateRequest struct {
 func (x *FloRssCreateRequest) Reset() {
 	*x = FloRssCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flogram_proto_msgTypes[5]
+		mi := &file_flogram_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -460,7 +2669,7 @@ func (x *FloRssCreateRequest) String() string {
 func (*FloRssCreateRequest) ProtoMessage() {}
 
 func (x *FloRssCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flogram_proto_msgTypes[5]
+	mi := &file_flogram_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +2682,7 @@ func (x *FloRssCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloRssCreateRequest.ProtoReflect.Descriptor instead.
 func (*FloRssCreateRequest) Descriptor() ([]byte, []int) {
-	return file_flogram_proto_rawDescGZIP(), []int{5}
+	return file_flogram_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *FloRssCreateRequest) GetFlags() int32 {
@@ -497,87 +2706,506 @@ var file_flogram_proto_rawDesc = []byte{
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a,
-	0x0a, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x46, 0x4c, 0x4f, 0x5f, 0x4d,
-	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55,
-	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x01,
+	0x0a, 0x0a, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x52, 0x0c, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x46, 0x4c,
+	0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xe0, 0x07, 0x0a, 0x0b, 0x46, 0x4c, 0x4f,
+	0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x55, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x13, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33,
+	0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x52, 0x45, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35,
+	0x0a, 0x17, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x17, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x6e,
-	0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x72, 0x0a, 0x16, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x47,
-	0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x71, 0x0a, 0x17, 0x46, 0x6c,
-	0x6f, 0x74, 0x67, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x6d, 0x70, 0x52, 0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x46, 0x4c, 0x4f, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x4d, 0x45, 0x4e, 0x54, 0x52, 0x0b,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x46, 0x4c, 0x4f, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x78,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x78,
+	0x74, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x78, 0x74, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x3a, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x55, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x18, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f,
+	0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x54, 0x6f,
+	0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0xa1, 0x01, 0x0a, 0x0a,
+	0x46, 0x4c, 0x4f, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0xfc, 0x01, 0x0a, 0x12, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x55, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0xca,
+	0x01, 0x0a, 0x0a, 0x46, 0x4c, 0x4f, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x12, 0x24, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x46, 0x4c,
+	0x4f, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xdd, 0x01, 0x0a, 0x0e,
+	0x46, 0x4c, 0x4f, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x4d, 0x45, 0x4e, 0x54, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x63, 0x0a, 0x14, 0x46,
+	0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x53,
+	0x49, 0x4f, 0x4e, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x61, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x35, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x49, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x70, 0x0a,
-	0x0a, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x46, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x73, 0x73, 0x5f, 0x75, 0x72,
-	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x73, 0x73, 0x55, 0x72, 0x69, 0x22,
-	0x4a, 0x0a, 0x13, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x2a, 0x46, 0x0a, 0x05, 0x46,
-	0x4c, 0x41, 0x47, 0x53, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10,
-	0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x31, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x54, 0x67, 0x10,
-	0x02, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x10, 0x10, 0x32, 0xb6, 0x01, 0x0a, 0x0c, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x46, 0x6c,
-	0x6f, 0x74, 0x67, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x16, 0x46,
+	0x6c, 0x6f, 0x74, 0x67, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xe6, 0x04, 0x0a, 0x17, 0x46, 0x6c, 0x6f, 0x74, 0x67,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a,
+	0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x46,
+	0x6c, 0x6f, 0x74, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x75, 0x69,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x92, 0x01, 0x0a, 0x1a, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x69, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x42, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22,
+	0xc0, 0x01, 0x0a, 0x15, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x22, 0x52, 0x0a, 0x17, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x69, 0x61, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x74, 0x67,
+	0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x41, 0x74, 0x22, 0x55, 0x0a, 0x16, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x14, 0x46, 0x6c, 0x6f,
+	0x74, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x69, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22,
+	0x87, 0x02, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x10, 0x46, 0x6c, 0x6f,
+	0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x7a, 0x0a, 0x0f, 0x46, 0x6c, 0x6f,
+	0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x46, 0x6c,
+	0x6f, 0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x70, 0x63,
+	0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x70, 0x63, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x46,
+	0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x73, 0x73, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x73, 0x73, 0x55, 0x72, 0x69, 0x22, 0x4a, 0x0a, 0x13, 0x46, 0x6c, 0x6f, 0x52, 0x73,
+	0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x55, 0x69, 0x64, 0x2a, 0xd4, 0x01, 0x0a, 0x05, 0x46, 0x4c, 0x41, 0x47, 0x53, 0x12, 0x0b, 0x0a,
+	0x07, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x31,
+	0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x54, 0x67, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x10, 0x08, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x10, 0x10, 0x12, 0x15, 0x0a, 0x11,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x10, 0x20, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x67, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x10, 0x40, 0x12, 0x0c, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x80,
+	0x02, 0x12, 0x0c, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x10, 0x80, 0x04, 0x12,
+	0x08, 0x0a, 0x03, 0x42, 0x6f, 0x74, 0x10, 0x80, 0x08, 0x12, 0x09, 0x0a, 0x04, 0x53, 0x63, 0x61,
+	0x6d, 0x10, 0x80, 0x10, 0x12, 0x09, 0x0a, 0x04, 0x46, 0x61, 0x6b, 0x65, 0x10, 0x80, 0x20, 0x12,
+	0x0d, 0x0a, 0x08, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x80, 0x40, 0x12, 0x0d,
+	0x0a, 0x07, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x10, 0x80, 0x80, 0x01, 0x12, 0x0b, 0x0a,
+	0x05, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x10, 0x80, 0x80, 0x02, 0x2a, 0xd1, 0x03, 0x0a, 0x17, 0x46,
+	0x4c, 0x4f, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x04, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64,
+	0x64, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x10, 0x06, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4a,
+	0x6f, 0x69, 0x6e, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x07, 0x12, 0x15,
+	0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x10, 0x09, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x61,
+	0x6c, 0x6c, 0x45, 0x6e, 0x64, 0x10, 0x0a, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x10, 0x0c,
+	0x12, 0x10, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x10, 0x0d, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x10, 0x0f, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x61, 0x6c, 0x6c,
+	0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x11, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x64, 0x69, 0x74, 0x10, 0x12, 0x2a, 0x8a,
+	0x03, 0x0a, 0x0f, 0x46, 0x4c, 0x4f, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42,
+	0x6f, 0x6c, 0x64, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x74, 0x61, 0x6c, 0x69, 0x63, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x10, 0x04, 0x12, 0x11,
+	0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x70, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x10,
+	0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x10,
+	0x06, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x65, 0x10, 0x07,
+	0x12, 0x14, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x65, 0x78, 0x74, 0x55, 0x72, 0x6c, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x55, 0x72, 0x6c, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x0d, 0x12, 0x15, 0x0a,
+	0x11, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x10, 0x0e, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x48, 0x61,
+	0x73, 0x68, 0x74, 0x61, 0x67, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x10, 0x10, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x11,
+	0x12, 0x12, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x61,
+	0x72, 0x64, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x10, 0x13, 0x2a, 0x83, 0x01, 0x0a, 0x13,
+	0x46, 0x4c, 0x4f, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x10, 0x01, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x10,
+	0x04, 0x2a, 0x4d, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x02,
+	0x2a, 0xc3, 0x01, 0x0a, 0x0e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x57, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x10,
+	0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x64, 0x65, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x57, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x10, 0x05, 0x12,
+	0x11, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0x08, 0x2a, 0x37, 0x0a, 0x0e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74,
+	0x41, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x32,
+	0x9f, 0x07, 0x0a, 0x0c, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x34, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x13, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x46, 0x6c,
+	0x6f, 0x74, 0x67, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x10, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x12, 0x35, 0x0a, 0x11, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x13, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x18, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x46,
-	0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x30, 0x01, 0x32, 0xd2, 0x01, 0x0a,
-	0x0d, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x46, 0x65, 0x65, 0x64, 0x30,
-	0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12,
-	0x14, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x46, 0x65,
-	0x65, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64,
-	0x12, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x46, 0x65, 0x65, 0x64, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x46, 0x65, 0x65,
-	0x64, 0x1a, 0x0c, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x30,
-	0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x66, 0x6c, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x6c, 0x61, 0x62, 0x2f, 0x77, 0x61, 0x79, 0x6f,
-	0x75, 0x74, 0x2f, 0x66, 0x6c, 0x6f, 0x5f, 0x74, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x12, 0x3c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x0b, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x30, 0x01, 0x12,
+	0x41, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x15, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67,
+	0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x30, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67,
+	0x73, 0x12, 0x18, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x61,
+	0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x46, 0x6c,
+	0x6f, 0x74, 0x67, 0x44, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x46,
+	0x6c, 0x6f, 0x74, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x30,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x13, 0x2e, 0x46, 0x6c,
+	0x6f, 0x74, 0x67, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x30, 0x01,
+	0x12, 0x30, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x13,
+	0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x30, 0x01, 0x12, 0x30, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12,
+	0x11, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x11, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x46, 0x6c, 0x6f, 0x74,
+	0x67, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x12, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x11, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x10, 0x2e, 0x46, 0x6c, 0x6f, 0x74, 0x67, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x32, 0xd2, 0x01, 0x0a, 0x0d, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73,
+	0x46, 0x65, 0x65, 0x64, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x14, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x46, 0x6c, 0x6f,
+	0x52, 0x73, 0x73, 0x46, 0x65, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x52, 0x73, 0x73, 0x46, 0x65,
+	0x65, 0x64, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x52,
+	0x73, 0x73, 0x46, 0x65, 0x65, 0x64, 0x1a, 0x0c, 0x2e, 0x46, 0x4c, 0x4f, 0x5f, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x30, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x6c, 0x61, 0x62,
+	0x2f, 0x77, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2f, 0x66, 0x6c, 0x6f, 0x5f, 0x74, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -592,40 +3220,120 @@ func file_flogram_proto_rawDescGZIP() []byte {
 	return file_flogram_proto_rawDescData
 }
 
-var file_flogram_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_flogram_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_flogram_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_flogram_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_flogram_proto_goTypes = []interface{}{
-	(FLAGS)(0),                      // 0: FLAGS
-	(*FLO_SOURCE)(nil),              // 1: FLO_SOURCE
-	(*FLO_MESSAGE)(nil),             // 2: FLO_MESSAGE
-	(*FlotgGetSourcesRequest)(nil),  // 3: FlotgGetSourcesRequest
-	(*FlotgGetMessagesRequest)(nil), // 4: FlotgGetMessagesRequest
-	(*FloRssFeed)(nil),              // 5: FloRssFeed
-	(*FloRssCreateRequest)(nil),     // 6: FloRssCreateRequest
-	(*timestamppb.Timestamp)(nil),   // 7: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),           // 8: google.protobuf.Empty
+	(FLAGS)(0),                         // 0: FLAGS
+	(FLO_SERVICE_ACTION_TYPE)(0),       // 1: FLO_SERVICE_ACTION_TYPE
+	(FLO_ENTITY_TYPE)(0),               // 2: FLO_ENTITY_TYPE
+	(FLO_ATTACHMENT_TYPE)(0),           // 3: FLO_ATTACHMENT_TYPE
+	(FlotgDeletedFilter)(0),            // 4: FlotgDeletedFilter
+	(FlotgAuthState)(0),                // 5: FlotgAuthState
+	(FlotgSortOrder)(0),                // 6: FlotgSortOrder
+	(*FLO_SOURCE)(nil),                 // 7: FLO_SOURCE
+	(*FLO_SOURCE_TITLE)(nil),           // 8: FLO_SOURCE_TITLE
+	(*FLO_MESSAGE)(nil),                // 9: FLO_MESSAGE
+	(*FLO_AUTHOR)(nil),                 // 10: FLO_AUTHOR
+	(*FLO_SERVICE_ACTION)(nil),         // 11: FLO_SERVICE_ACTION
+	(*FLO_ENTITY)(nil),                 // 12: FLO_ENTITY
+	(*FLO_ATTACHMENT)(nil),             // 13: FLO_ATTACHMENT
+	(*FLO_MESSAGE_REVISION)(nil),       // 14: FLO_MESSAGE_REVISION
+	(*FlotgReadyResponse)(nil),         // 15: FlotgReadyResponse
+	(*FlotgComponentStatus)(nil),       // 16: FlotgComponentStatus
+	(*FlotgSourceRequest)(nil),         // 17: FlotgSourceRequest
+	(*FlotgGetSourcesRequest)(nil),     // 18: FlotgGetSourcesRequest
+	(*FlotgGetMessagesRequest)(nil),    // 19: FlotgGetMessagesRequest
+	(*FlotgStreamMessagesRequest)(nil), // 20: FlotgStreamMessagesRequest
+	(*FlotgBackfillRequest)(nil),       // 21: FlotgBackfillRequest
+	(*FlotgBackfillProgress)(nil),      // 22: FlotgBackfillProgress
+	(*FlotgListDialogsRequest)(nil),    // 23: FlotgListDialogsRequest
+	(*FlotgDialog)(nil),                // 24: FlotgDialog
+	(*FlotgAttachmentRequest)(nil),     // 25: FlotgAttachmentRequest
+	(*FlotgAttachmentChunk)(nil),       // 26: FlotgAttachmentChunk
+	(*FlotgThreadRequest)(nil),         // 27: FlotgThreadRequest
+	(*FlotgTopic)(nil),                 // 28: FlotgTopic
+	(*FlotgAuthRequest)(nil),           // 29: FlotgAuthRequest
+	(*FlotgAuthStatus)(nil),            // 30: FlotgAuthStatus
+	(*FloRssFeed)(nil),                 // 31: FloRssFeed
+	(*FloRssCreateRequest)(nil),        // 32: FloRssCreateRequest
+	(*timestamppb.Timestamp)(nil),      // 33: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 34: google.protobuf.Empty
 }
 var file_flogram_proto_depIdxs = []int32{
-	7, // 0: FLO_MESSAGE.created_at:type_name -> google.protobuf.Timestamp
-	8, // 1: FlotgService.Ready:input_type -> google.protobuf.Empty
-	3, // 2: FlotgService.GetSources:input_type -> FlotgGetSourcesRequest
-	4, // 3: FlotgService.GetMessages:input_type -> FlotgGetMessagesRequest
-	8, // 4: FloRssService.GetFeeds:input_type -> google.protobuf.Empty
-	6, // 5: FloRssService.CreateFeed:input_type -> FloRssCreateRequest
-	5, // 6: FloRssService.DeleteFeed:input_type -> FloRssFeed
-	5, // 7: FloRssService.GetMessages:input_type -> FloRssFeed
-	8, // 8: FlotgService.Ready:output_type -> google.protobuf.Empty
-	1, // 9: FlotgService.GetSources:output_type -> FLO_SOURCE
-	2, // 10: FlotgService.GetMessages:output_type -> FLO_MESSAGE
-	5, // 11: FloRssService.GetFeeds:output_type -> FloRssFeed
-	5, // 12: FloRssService.CreateFeed:output_type -> FloRssFeed
-	8, // 13: FloRssService.DeleteFeed:output_type -> google.protobuf.Empty
-	2, // 14: FloRssService.GetMessages:output_type -> FLO_MESSAGE
-	8, // [8:15] is the sub-list for method output_type
-	1, // [1:8] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: FLO_SOURCE.title_history:type_name -> FLO_SOURCE_TITLE
+	33, // 1: FLO_SOURCE_TITLE.since:type_name -> google.protobuf.Timestamp
+	33, // 2: FLO_SOURCE_TITLE.until:type_name -> google.protobuf.Timestamp
+	33, // 3: FLO_MESSAGE.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: FLO_MESSAGE.forward_from_source:type_name -> FLO_SOURCE
+	33, // 5: FLO_MESSAGE.edited_at:type_name -> google.protobuf.Timestamp
+	14, // 6: FLO_MESSAGE.revisions:type_name -> FLO_MESSAGE_REVISION
+	33, // 7: FLO_MESSAGE.deleted_at:type_name -> google.protobuf.Timestamp
+	33, // 8: FLO_MESSAGE.forward_from_created_at:type_name -> google.protobuf.Timestamp
+	13, // 9: FLO_MESSAGE.attachments:type_name -> FLO_ATTACHMENT
+	12, // 10: FLO_MESSAGE.entities:type_name -> FLO_ENTITY
+	11, // 11: FLO_MESSAGE.service_action:type_name -> FLO_SERVICE_ACTION
+	10, // 12: FLO_MESSAGE.author:type_name -> FLO_AUTHOR
+	1,  // 13: FLO_SERVICE_ACTION.type:type_name -> FLO_SERVICE_ACTION_TYPE
+	33, // 14: FLO_SERVICE_ACTION.scheduled_at:type_name -> google.protobuf.Timestamp
+	2,  // 15: FLO_ENTITY.type:type_name -> FLO_ENTITY_TYPE
+	3,  // 16: FLO_ATTACHMENT.type:type_name -> FLO_ATTACHMENT_TYPE
+	33, // 17: FLO_MESSAGE_REVISION.edited_at:type_name -> google.protobuf.Timestamp
+	16, // 18: FlotgReadyResponse.components:type_name -> FlotgComponentStatus
+	33, // 19: FlotgComponentStatus.updated_at:type_name -> google.protobuf.Timestamp
+	33, // 20: FlotgGetMessagesRequest.messages_since:type_name -> google.protobuf.Timestamp
+	33, // 21: FlotgGetMessagesRequest.messages_before:type_name -> google.protobuf.Timestamp
+	6,  // 22: FlotgGetMessagesRequest.sort_order:type_name -> FlotgSortOrder
+	4,  // 23: FlotgGetMessagesRequest.deleted:type_name -> FlotgDeletedFilter
+	33, // 24: FlotgStreamMessagesRequest.replay_since:type_name -> google.protobuf.Timestamp
+	7,  // 25: FlotgDialog.source:type_name -> FLO_SOURCE
+	33, // 26: FlotgDialog.last_message_at:type_name -> google.protobuf.Timestamp
+	33, // 27: FlotgTopic.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 28: FlotgAuthStatus.state:type_name -> FlotgAuthState
+	34, // 29: FlotgService.Ready:input_type -> google.protobuf.Empty
+	18, // 30: FlotgService.GetSources:input_type -> FlotgGetSourcesRequest
+	19, // 31: FlotgService.GetMessages:input_type -> FlotgGetMessagesRequest
+	20, // 32: FlotgService.StreamMessages:input_type -> FlotgStreamMessagesRequest
+	17, // 33: FlotgService.EnableMonitoring:input_type -> FlotgSourceRequest
+	17, // 34: FlotgService.DisableMonitoring:input_type -> FlotgSourceRequest
+	34, // 35: FlotgService.GetMonitoredSources:input_type -> google.protobuf.Empty
+	21, // 36: FlotgService.BackfillSource:input_type -> FlotgBackfillRequest
+	23, // 37: FlotgService.ListDialogs:input_type -> FlotgListDialogsRequest
+	25, // 38: FlotgService.GetAttachment:input_type -> FlotgAttachmentRequest
+	27, // 39: FlotgService.GetThread:input_type -> FlotgThreadRequest
+	17, // 40: FlotgService.ListTopics:input_type -> FlotgSourceRequest
+	29, // 41: FlotgService.AuthBegin:input_type -> FlotgAuthRequest
+	29, // 42: FlotgService.AuthSubmitCode:input_type -> FlotgAuthRequest
+	29, // 43: FlotgService.AuthSubmitPassword:input_type -> FlotgAuthRequest
+	34, // 44: FlotgService.AuthGetStatus:input_type -> google.protobuf.Empty
+	34, // 45: FloRssService.GetFeeds:input_type -> google.protobuf.Empty
+	32, // 46: FloRssService.CreateFeed:input_type -> FloRssCreateRequest
+	31, // 47: FloRssService.DeleteFeed:input_type -> FloRssFeed
+	31, // 48: FloRssService.GetMessages:input_type -> FloRssFeed
+	15, // 49: FlotgService.Ready:output_type -> FlotgReadyResponse
+	7,  // 50: FlotgService.GetSources:output_type -> FLO_SOURCE
+	9,  // 51: FlotgService.GetMessages:output_type -> FLO_MESSAGE
+	9,  // 52: FlotgService.StreamMessages:output_type -> FLO_MESSAGE
+	7,  // 53: FlotgService.EnableMonitoring:output_type -> FLO_SOURCE
+	7,  // 54: FlotgService.DisableMonitoring:output_type -> FLO_SOURCE
+	7,  // 55: FlotgService.GetMonitoredSources:output_type -> FLO_SOURCE
+	22, // 56: FlotgService.BackfillSource:output_type -> FlotgBackfillProgress
+	24, // 57: FlotgService.ListDialogs:output_type -> FlotgDialog
+	26, // 58: FlotgService.GetAttachment:output_type -> FlotgAttachmentChunk
+	9,  // 59: FlotgService.GetThread:output_type -> FLO_MESSAGE
+	28, // 60: FlotgService.ListTopics:output_type -> FlotgTopic
+	30, // 61: FlotgService.AuthBegin:output_type -> FlotgAuthStatus
+	30, // 62: FlotgService.AuthSubmitCode:output_type -> FlotgAuthStatus
+	30, // 63: FlotgService.AuthSubmitPassword:output_type -> FlotgAuthStatus
+	30, // 64: FlotgService.AuthGetStatus:output_type -> FlotgAuthStatus
+	31, // 65: FloRssService.GetFeeds:output_type -> FloRssFeed
+	31, // 66: FloRssService.CreateFeed:output_type -> FloRssFeed
+	34, // 67: FloRssService.DeleteFeed:output_type -> google.protobuf.Empty
+	9,  // 68: FloRssService.GetMessages:output_type -> FLO_MESSAGE
+	49, // [49:69] is the sub-list for method output_type
+	29, // [29:49] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_flogram_proto_init() }
@@ -647,7 +3355,7 @@ func file_flogram_proto_init() {
 			}
 		}
 		file_flogram_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FLO_MESSAGE); i {
+			switch v := v.(*FLO_SOURCE_TITLE); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -659,7 +3367,7 @@ func file_flogram_proto_init() {
 			}
 		}
 		file_flogram_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlotgGetSourcesRequest); i {
+			switch v := v.(*FLO_MESSAGE); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -671,7 +3379,7 @@ func file_flogram_proto_init() {
 			}
 		}
 		file_flogram_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlotgGetMessagesRequest); i {
+			switch v := v.(*FLO_AUTHOR); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -683,7 +3391,7 @@ func file_flogram_proto_init() {
 			}
 		}
 		file_flogram_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloRssFeed); i {
+			switch v := v.(*FLO_SERVICE_ACTION); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -695,6 +3403,246 @@ func file_flogram_proto_init() {
 			}
 		}
 		file_flogram_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FLO_ENTITY); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FLO_ATTACHMENT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FLO_MESSAGE_REVISION); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgReadyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgComponentStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgGetSourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgGetMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgStreamMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgBackfillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgBackfillProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgListDialogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgDialog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgAttachmentChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgThreadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgTopic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgAuthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlotgAuthStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloRssFeed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flogram_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FloRssCreateRequest); i {
 			case 0:
 				return &v.state
@@ -712,8 +3660,8 @@ func file_flogram_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_flogram_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      7,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
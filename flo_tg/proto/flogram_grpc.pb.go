@@ -23,9 +23,23 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FlotgServiceClient interface {
-	Ready(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Ready(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlotgReadyResponse, error)
 	GetSources(ctx context.Context, in *FlotgGetSourcesRequest, opts ...grpc.CallOption) (FlotgService_GetSourcesClient, error)
 	GetMessages(ctx context.Context, in *FlotgGetMessagesRequest, opts ...grpc.CallOption) (FlotgService_GetMessagesClient, error)
+	StreamMessages(ctx context.Context, in *FlotgStreamMessagesRequest, opts ...grpc.CallOption) (FlotgService_StreamMessagesClient, error)
+	EnableMonitoring(ctx context.Context, in *FlotgSourceRequest, opts ...grpc.CallOption) (*FLO_SOURCE, error)
+	DisableMonitoring(ctx context.Context, in *FlotgSourceRequest, opts ...grpc.CallOption) (*FLO_SOURCE, error)
+	GetMonitoredSources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (FlotgService_GetMonitoredSourcesClient, error)
+	BackfillSource(ctx context.Context, in *FlotgBackfillRequest, opts ...grpc.CallOption) (FlotgService_BackfillSourceClient, error)
+	ListDialogs(ctx context.Context, in *FlotgListDialogsRequest, opts ...grpc.CallOption) (FlotgService_ListDialogsClient, error)
+	GetAttachment(ctx context.Context, in *FlotgAttachmentRequest, opts ...grpc.CallOption) (FlotgService_GetAttachmentClient, error)
+	GetThread(ctx context.Context, in *FlotgThreadRequest, opts ...grpc.CallOption) (FlotgService_GetThreadClient, error)
+	ListTopics(ctx context.Context, in *FlotgSourceRequest, opts ...grpc.CallOption) (FlotgService_ListTopicsClient, error)
+	// Telegram login, when flo_tg runs with TG_AUTH=rpc
+	AuthBegin(ctx context.Context, in *FlotgAuthRequest, opts ...grpc.CallOption) (*FlotgAuthStatus, error)
+	AuthSubmitCode(ctx context.Context, in *FlotgAuthRequest, opts ...grpc.CallOption) (*FlotgAuthStatus, error)
+	AuthSubmitPassword(ctx context.Context, in *FlotgAuthRequest, opts ...grpc.CallOption) (*FlotgAuthStatus, error)
+	AuthGetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlotgAuthStatus, error)
 }
 
 type flotgServiceClient struct {
@@ -36,8 +50,8 @@ func NewFlotgServiceClient(cc grpc.ClientConnInterface) FlotgServiceClient {
 	return &flotgServiceClient{cc}
 }
 
-func (c *flotgServiceClient) Ready(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *flotgServiceClient) Ready(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlotgReadyResponse, error) {
+	out := new(FlotgReadyResponse)
 	err := c.cc.Invoke(ctx, "/FlotgService/Ready", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -109,13 +123,305 @@ func (x *flotgServiceGetMessagesClient) Recv() (*FLO_MESSAGE, error) {
 	return m, nil
 }
 
+func (c *flotgServiceClient) StreamMessages(ctx context.Context, in *FlotgStreamMessagesRequest, opts ...grpc.CallOption) (FlotgService_StreamMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlotgService_ServiceDesc.Streams[2], "/FlotgService/StreamMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flotgServiceStreamMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlotgService_StreamMessagesClient interface {
+	Recv() (*FLO_MESSAGE, error)
+	grpc.ClientStream
+}
+
+type flotgServiceStreamMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *flotgServiceStreamMessagesClient) Recv() (*FLO_MESSAGE, error) {
+	m := new(FLO_MESSAGE)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flotgServiceClient) EnableMonitoring(ctx context.Context, in *FlotgSourceRequest, opts ...grpc.CallOption) (*FLO_SOURCE, error) {
+	out := new(FLO_SOURCE)
+	err := c.cc.Invoke(ctx, "/FlotgService/EnableMonitoring", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotgServiceClient) DisableMonitoring(ctx context.Context, in *FlotgSourceRequest, opts ...grpc.CallOption) (*FLO_SOURCE, error) {
+	out := new(FLO_SOURCE)
+	err := c.cc.Invoke(ctx, "/FlotgService/DisableMonitoring", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotgServiceClient) GetMonitoredSources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (FlotgService_GetMonitoredSourcesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlotgService_ServiceDesc.Streams[3], "/FlotgService/GetMonitoredSources", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flotgServiceGetMonitoredSourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlotgService_GetMonitoredSourcesClient interface {
+	Recv() (*FLO_SOURCE, error)
+	grpc.ClientStream
+}
+
+type flotgServiceGetMonitoredSourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *flotgServiceGetMonitoredSourcesClient) Recv() (*FLO_SOURCE, error) {
+	m := new(FLO_SOURCE)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flotgServiceClient) BackfillSource(ctx context.Context, in *FlotgBackfillRequest, opts ...grpc.CallOption) (FlotgService_BackfillSourceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlotgService_ServiceDesc.Streams[4], "/FlotgService/BackfillSource", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flotgServiceBackfillSourceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlotgService_BackfillSourceClient interface {
+	Recv() (*FlotgBackfillProgress, error)
+	grpc.ClientStream
+}
+
+type flotgServiceBackfillSourceClient struct {
+	grpc.ClientStream
+}
+
+func (x *flotgServiceBackfillSourceClient) Recv() (*FlotgBackfillProgress, error) {
+	m := new(FlotgBackfillProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flotgServiceClient) ListDialogs(ctx context.Context, in *FlotgListDialogsRequest, opts ...grpc.CallOption) (FlotgService_ListDialogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlotgService_ServiceDesc.Streams[5], "/FlotgService/ListDialogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flotgServiceListDialogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlotgService_ListDialogsClient interface {
+	Recv() (*FlotgDialog, error)
+	grpc.ClientStream
+}
+
+type flotgServiceListDialogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *flotgServiceListDialogsClient) Recv() (*FlotgDialog, error) {
+	m := new(FlotgDialog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flotgServiceClient) GetAttachment(ctx context.Context, in *FlotgAttachmentRequest, opts ...grpc.CallOption) (FlotgService_GetAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlotgService_ServiceDesc.Streams[6], "/FlotgService/GetAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flotgServiceGetAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlotgService_GetAttachmentClient interface {
+	Recv() (*FlotgAttachmentChunk, error)
+	grpc.ClientStream
+}
+
+type flotgServiceGetAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *flotgServiceGetAttachmentClient) Recv() (*FlotgAttachmentChunk, error) {
+	m := new(FlotgAttachmentChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flotgServiceClient) GetThread(ctx context.Context, in *FlotgThreadRequest, opts ...grpc.CallOption) (FlotgService_GetThreadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlotgService_ServiceDesc.Streams[7], "/FlotgService/GetThread", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flotgServiceGetThreadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlotgService_GetThreadClient interface {
+	Recv() (*FLO_MESSAGE, error)
+	grpc.ClientStream
+}
+
+type flotgServiceGetThreadClient struct {
+	grpc.ClientStream
+}
+
+func (x *flotgServiceGetThreadClient) Recv() (*FLO_MESSAGE, error) {
+	m := new(FLO_MESSAGE)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flotgServiceClient) ListTopics(ctx context.Context, in *FlotgSourceRequest, opts ...grpc.CallOption) (FlotgService_ListTopicsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FlotgService_ServiceDesc.Streams[8], "/FlotgService/ListTopics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flotgServiceListTopicsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlotgService_ListTopicsClient interface {
+	Recv() (*FlotgTopic, error)
+	grpc.ClientStream
+}
+
+type flotgServiceListTopicsClient struct {
+	grpc.ClientStream
+}
+
+func (x *flotgServiceListTopicsClient) Recv() (*FlotgTopic, error) {
+	m := new(FlotgTopic)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flotgServiceClient) AuthBegin(ctx context.Context, in *FlotgAuthRequest, opts ...grpc.CallOption) (*FlotgAuthStatus, error) {
+	out := new(FlotgAuthStatus)
+	err := c.cc.Invoke(ctx, "/FlotgService/AuthBegin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotgServiceClient) AuthSubmitCode(ctx context.Context, in *FlotgAuthRequest, opts ...grpc.CallOption) (*FlotgAuthStatus, error) {
+	out := new(FlotgAuthStatus)
+	err := c.cc.Invoke(ctx, "/FlotgService/AuthSubmitCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotgServiceClient) AuthSubmitPassword(ctx context.Context, in *FlotgAuthRequest, opts ...grpc.CallOption) (*FlotgAuthStatus, error) {
+	out := new(FlotgAuthStatus)
+	err := c.cc.Invoke(ctx, "/FlotgService/AuthSubmitPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotgServiceClient) AuthGetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlotgAuthStatus, error) {
+	out := new(FlotgAuthStatus)
+	err := c.cc.Invoke(ctx, "/FlotgService/AuthGetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FlotgServiceServer is the server API for FlotgService service.
 // All implementations must embed UnimplementedFlotgServiceServer
 // for forward compatibility
 type FlotgServiceServer interface {
-	Ready(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Ready(context.Context, *emptypb.Empty) (*FlotgReadyResponse, error)
 	GetSources(*FlotgGetSourcesRequest, FlotgService_GetSourcesServer) error
 	GetMessages(*FlotgGetMessagesRequest, FlotgService_GetMessagesServer) error
+	StreamMessages(*FlotgStreamMessagesRequest, FlotgService_StreamMessagesServer) error
+	EnableMonitoring(context.Context, *FlotgSourceRequest) (*FLO_SOURCE, error)
+	DisableMonitoring(context.Context, *FlotgSourceRequest) (*FLO_SOURCE, error)
+	GetMonitoredSources(*emptypb.Empty, FlotgService_GetMonitoredSourcesServer) error
+	BackfillSource(*FlotgBackfillRequest, FlotgService_BackfillSourceServer) error
+	ListDialogs(*FlotgListDialogsRequest, FlotgService_ListDialogsServer) error
+	GetAttachment(*FlotgAttachmentRequest, FlotgService_GetAttachmentServer) error
+	GetThread(*FlotgThreadRequest, FlotgService_GetThreadServer) error
+	ListTopics(*FlotgSourceRequest, FlotgService_ListTopicsServer) error
+	// Telegram login, when flo_tg runs with TG_AUTH=rpc
+	AuthBegin(context.Context, *FlotgAuthRequest) (*FlotgAuthStatus, error)
+	AuthSubmitCode(context.Context, *FlotgAuthRequest) (*FlotgAuthStatus, error)
+	AuthSubmitPassword(context.Context, *FlotgAuthRequest) (*FlotgAuthStatus, error)
+	AuthGetStatus(context.Context, *emptypb.Empty) (*FlotgAuthStatus, error)
 	mustEmbedUnimplementedFlotgServiceServer()
 }
 
@@ -123,7 +429,7 @@ type FlotgServiceServer interface {
 type UnimplementedFlotgServiceServer struct {
 }
 
-func (UnimplementedFlotgServiceServer) Ready(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+func (UnimplementedFlotgServiceServer) Ready(context.Context, *emptypb.Empty) (*FlotgReadyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ready not implemented")
 }
 func (UnimplementedFlotgServiceServer) GetSources(*FlotgGetSourcesRequest, FlotgService_GetSourcesServer) error {
@@ -132,6 +438,45 @@ func (UnimplementedFlotgServiceServer) GetSources(*FlotgGetSourcesRequest, Flotg
 func (UnimplementedFlotgServiceServer) GetMessages(*FlotgGetMessagesRequest, FlotgService_GetMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
 }
+func (UnimplementedFlotgServiceServer) StreamMessages(*FlotgStreamMessagesRequest, FlotgService_StreamMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMessages not implemented")
+}
+func (UnimplementedFlotgServiceServer) EnableMonitoring(context.Context, *FlotgSourceRequest) (*FLO_SOURCE, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMonitoring not implemented")
+}
+func (UnimplementedFlotgServiceServer) DisableMonitoring(context.Context, *FlotgSourceRequest) (*FLO_SOURCE, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMonitoring not implemented")
+}
+func (UnimplementedFlotgServiceServer) GetMonitoredSources(*emptypb.Empty, FlotgService_GetMonitoredSourcesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetMonitoredSources not implemented")
+}
+func (UnimplementedFlotgServiceServer) BackfillSource(*FlotgBackfillRequest, FlotgService_BackfillSourceServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackfillSource not implemented")
+}
+func (UnimplementedFlotgServiceServer) ListDialogs(*FlotgListDialogsRequest, FlotgService_ListDialogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDialogs not implemented")
+}
+func (UnimplementedFlotgServiceServer) GetAttachment(*FlotgAttachmentRequest, FlotgService_GetAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedFlotgServiceServer) GetThread(*FlotgThreadRequest, FlotgService_GetThreadServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (UnimplementedFlotgServiceServer) ListTopics(*FlotgSourceRequest, FlotgService_ListTopicsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
+func (UnimplementedFlotgServiceServer) AuthBegin(context.Context, *FlotgAuthRequest) (*FlotgAuthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthBegin not implemented")
+}
+func (UnimplementedFlotgServiceServer) AuthSubmitCode(context.Context, *FlotgAuthRequest) (*FlotgAuthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthSubmitCode not implemented")
+}
+func (UnimplementedFlotgServiceServer) AuthSubmitPassword(context.Context, *FlotgAuthRequest) (*FlotgAuthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthSubmitPassword not implemented")
+}
+func (UnimplementedFlotgServiceServer) AuthGetStatus(context.Context, *emptypb.Empty) (*FlotgAuthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthGetStatus not implemented")
+}
 func (UnimplementedFlotgServiceServer) mustEmbedUnimplementedFlotgServiceServer() {}
 
 // UnsafeFlotgServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -205,6 +550,261 @@ func (x *flotgServiceGetMessagesServer) Send(m *FLO_MESSAGE) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _FlotgService_StreamMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlotgStreamMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlotgServiceServer).StreamMessages(m, &flotgServiceStreamMessagesServer{stream})
+}
+
+type FlotgService_StreamMessagesServer interface {
+	Send(*FLO_MESSAGE) error
+	grpc.ServerStream
+}
+
+type flotgServiceStreamMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *flotgServiceStreamMessagesServer) Send(m *FLO_MESSAGE) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlotgService_EnableMonitoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlotgSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotgServiceServer).EnableMonitoring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/FlotgService/EnableMonitoring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotgServiceServer).EnableMonitoring(ctx, req.(*FlotgSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlotgService_DisableMonitoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlotgSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotgServiceServer).DisableMonitoring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/FlotgService/DisableMonitoring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotgServiceServer).DisableMonitoring(ctx, req.(*FlotgSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlotgService_GetMonitoredSources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlotgServiceServer).GetMonitoredSources(m, &flotgServiceGetMonitoredSourcesServer{stream})
+}
+
+type FlotgService_GetMonitoredSourcesServer interface {
+	Send(*FLO_SOURCE) error
+	grpc.ServerStream
+}
+
+type flotgServiceGetMonitoredSourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *flotgServiceGetMonitoredSourcesServer) Send(m *FLO_SOURCE) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlotgService_BackfillSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlotgBackfillRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlotgServiceServer).BackfillSource(m, &flotgServiceBackfillSourceServer{stream})
+}
+
+type FlotgService_BackfillSourceServer interface {
+	Send(*FlotgBackfillProgress) error
+	grpc.ServerStream
+}
+
+type flotgServiceBackfillSourceServer struct {
+	grpc.ServerStream
+}
+
+func (x *flotgServiceBackfillSourceServer) Send(m *FlotgBackfillProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlotgService_ListDialogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlotgListDialogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlotgServiceServer).ListDialogs(m, &flotgServiceListDialogsServer{stream})
+}
+
+type FlotgService_ListDialogsServer interface {
+	Send(*FlotgDialog) error
+	grpc.ServerStream
+}
+
+type flotgServiceListDialogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *flotgServiceListDialogsServer) Send(m *FlotgDialog) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlotgService_GetAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlotgAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlotgServiceServer).GetAttachment(m, &flotgServiceGetAttachmentServer{stream})
+}
+
+type FlotgService_GetAttachmentServer interface {
+	Send(*FlotgAttachmentChunk) error
+	grpc.ServerStream
+}
+
+type flotgServiceGetAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *flotgServiceGetAttachmentServer) Send(m *FlotgAttachmentChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlotgService_GetThread_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlotgThreadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlotgServiceServer).GetThread(m, &flotgServiceGetThreadServer{stream})
+}
+
+type FlotgService_GetThreadServer interface {
+	Send(*FLO_MESSAGE) error
+	grpc.ServerStream
+}
+
+type flotgServiceGetThreadServer struct {
+	grpc.ServerStream
+}
+
+func (x *flotgServiceGetThreadServer) Send(m *FLO_MESSAGE) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlotgService_ListTopics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlotgSourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlotgServiceServer).ListTopics(m, &flotgServiceListTopicsServer{stream})
+}
+
+type FlotgService_ListTopicsServer interface {
+	Send(*FlotgTopic) error
+	grpc.ServerStream
+}
+
+type flotgServiceListTopicsServer struct {
+	grpc.ServerStream
+}
+
+func (x *flotgServiceListTopicsServer) Send(m *FlotgTopic) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlotgService_AuthBegin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlotgAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotgServiceServer).AuthBegin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/FlotgService/AuthBegin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotgServiceServer).AuthBegin(ctx, req.(*FlotgAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlotgService_AuthSubmitCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlotgAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotgServiceServer).AuthSubmitCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/FlotgService/AuthSubmitCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotgServiceServer).AuthSubmitCode(ctx, req.(*FlotgAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlotgService_AuthSubmitPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlotgAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotgServiceServer).AuthSubmitPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/FlotgService/AuthSubmitPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotgServiceServer).AuthSubmitPassword(ctx, req.(*FlotgAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlotgService_AuthGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotgServiceServer).AuthGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/FlotgService/AuthGetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotgServiceServer).AuthGetStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FlotgService_ServiceDesc is the grpc.ServiceDesc for FlotgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -216,6 +816,30 @@ var FlotgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ready",
 			Handler:    _FlotgService_Ready_Handler,
 		},
+		{
+			MethodName: "EnableMonitoring",
+			Handler:    _FlotgService_EnableMonitoring_Handler,
+		},
+		{
+			MethodName: "DisableMonitoring",
+			Handler:    _FlotgService_DisableMonitoring_Handler,
+		},
+		{
+			MethodName: "AuthBegin",
+			Handler:    _FlotgService_AuthBegin_Handler,
+		},
+		{
+			MethodName: "AuthSubmitCode",
+			Handler:    _FlotgService_AuthSubmitCode_Handler,
+		},
+		{
+			MethodName: "AuthSubmitPassword",
+			Handler:    _FlotgService_AuthSubmitPassword_Handler,
+		},
+		{
+			MethodName: "AuthGetStatus",
+			Handler:    _FlotgService_AuthGetStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -228,6 +852,41 @@ var FlotgService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _FlotgService_GetMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamMessages",
+			Handler:       _FlotgService_StreamMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetMonitoredSources",
+			Handler:       _FlotgService_GetMonitoredSources_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BackfillSource",
+			Handler:       _FlotgService_BackfillSource_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDialogs",
+			Handler:       _FlotgService_ListDialogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAttachment",
+			Handler:       _FlotgService_GetAttachment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetThread",
+			Handler:       _FlotgService_GetThread_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTopics",
+			Handler:       _FlotgService_ListTopics_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "flogram.proto",
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -16,6 +17,9 @@ type Queue struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 	op     chan Op
+
+	mu      sync.RWMutex // guards op channel closing for TryEnqueue
+	stopped bool
 }
 
 // Makes new Queue (unintialized)
@@ -44,6 +48,10 @@ func (q *Queue) IsReady() bool {
 // Context passed to the operation func will tell it is cancelled if queue is stopping
 // TODO: block before Run() is exited?
 func (q *Queue) Stop() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.stopped = true
 	q.cancel()
 	close(q.op)
 	q.ctx = nil
@@ -71,6 +79,24 @@ func (q *Queue) Enqueue(op Op) {
 	q.op <- op
 }
 
+// Enqueue operation unless queue is stopped or full (never blocks).
+// Returns false if operation was not enqueued.
+func (q *Queue) TryEnqueue(op Op) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.stopped {
+		return false
+	}
+
+	select {
+	case q.op <- op:
+		return true
+	default:
+		return false
+	}
+}
+
 // Enqueue operation and wait before it is done.
 // This function may block for unlimited time.
 func (q *Queue) EnqueueAndWait(op Op) {
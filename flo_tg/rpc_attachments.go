@@ -0,0 +1,82 @@
+package main
+
+import (
+	"io"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/pkg/errors"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+const rpc_attachment_chunk_size = 256 * 1024
+
+// Stream downloaded attachment file in chunks. Blob store is read directly, not with the Queue.
+func (service rpcService) GetAttachment(request *proto.FlotgAttachmentRequest, stream proto.FlotgService_GetAttachmentServer) error {
+	defer stream.Context().Done()
+
+	const method = "GetAttachment"
+
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
+	}
+
+	if request.AttachmentUid == "" {
+		return errors.New("attachment_uid is required")
+	}
+
+	blobs := service.bootstrap.Blobs
+	if blobs == nil {
+		return errors.New("attachments are not downloaded, FLOTG_ATTACHMENTS is none")
+	}
+
+	r, size, err := blobs.Open(stream.Context(), request.AttachmentUid)
+	if err == errBlobNotFound {
+		return errors.New("attachment not found or not downloaded yet")
+	} else if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "Blob store open fail", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("storage read operation failed on backend")
+	}
+
+	defer r.Close()
+
+	buf := make([]byte, rpc_attachment_chunk_size)
+	offset := int64(0)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			sendErr := stream.Send(&proto.FlotgAttachmentChunk{
+				Size:   size,
+				Offset: offset,
+				Data:   buf[:n],
+			})
+			if sendErr != nil {
+				logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+					"err": sendErr,
+				})
+				return errors.New("streaming failed on backend")
+			}
+			offset += int64(n)
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "Blob store read fail", logInfo, map[string]any{
+				"err":    err,
+				"offset": offset,
+			})
+			return errors.New("storage read operation failed on backend")
+		}
+	}
+
+	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo, map[string]any{
+		"size": offset,
+	})
+
+	return nil
+}
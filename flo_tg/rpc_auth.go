@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+func (service rpcService) AuthBegin(ctx context.Context, request *proto.FlotgAuthRequest) (*proto.FlotgAuthStatus, error) {
+	return service.authStep(ctx, "AuthBegin", request, func(a *TelegramAuth) error {
+		return a.Begin(request.Phone)
+	})
+}
+
+func (service rpcService) AuthSubmitCode(ctx context.Context, request *proto.FlotgAuthRequest) (*proto.FlotgAuthStatus, error) {
+	return service.authStep(ctx, "AuthSubmitCode", request, func(a *TelegramAuth) error {
+		return a.SubmitCode(request.Code)
+	})
+}
+
+func (service rpcService) AuthSubmitPassword(ctx context.Context, request *proto.FlotgAuthRequest) (*proto.FlotgAuthStatus, error) {
+	return service.authStep(ctx, "AuthSubmitPassword", request, func(a *TelegramAuth) error {
+		return a.SubmitPassword(request.Password)
+	})
+}
+
+func (service rpcService) AuthGetStatus(ctx context.Context, request *emptypb.Empty) (*proto.FlotgAuthStatus, error) {
+	return service.bootstrap.TelegramAuth.Status(), nil
+}
+
+func (service rpcService) authStep(ctx context.Context, method string, request *proto.FlotgAuthRequest, step func(*TelegramAuth) error) (*proto.FlotgAuthStatus, error) {
+	// Login code and password are never logged
+	logger, logInfo := service.requestLogger(ctx, method, &proto.FlotgAuthRequest{
+		Flags: request.Flags,
+		Phone: request.Phone,
+	})
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return nil, err
+	}
+
+	if err := step(service.bootstrap.TelegramAuth); err != nil {
+		logger.Message(gelf.LOG_WARNING, "rpc_service", "Login step rejected", logInfo, map[string]any{
+			"err": err,
+		})
+		return nil, err
+	}
+
+	logger.Message(gelf.LOG_INFO, "rpc_service", "Request "+method+" completed", logInfo)
+
+	return service.bootstrap.TelegramAuth.Status(), nil
+}
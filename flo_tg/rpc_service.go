@@ -8,12 +8,17 @@ import (
 	"io/ioutil" // FIXME
 	"net"
 	"path"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gopkg.in/Graylog2/go-gelf.v2/gelf"
 
@@ -99,13 +104,14 @@ func (service *rpcService) Init() error {
 	service.server = grpc.NewServer(service.opts...)
 
 	proto.RegisterFlotgServiceServer(service.server, service)
+	healthpb.RegisterHealthServer(service.server, service.bootstrap.Health.Server())
 	reflection.Register(service.server)
 
 	address := fmt.Sprintf(":%d", service.bootstrap.ServicePort)
 
 	service.listener, err = net.Listen("tcp", address)
 
-	service.converter = newConverter(service.bootstrap)
+	service.converter = newConverter(service.bootstrap, nil)
 
 	return err
 }
@@ -135,15 +141,105 @@ func (service *rpcService) Serve() {
 	}
 }
 
-func (service rpcService) Ready(ctx context.Context, request *emptypb.Empty) (*emptypb.Empty, error) {
+// Logger for RPC request with request ID, and request fields for logging. Logs the request.
+func (service rpcService) requestLogger(ctx context.Context, method string, request any) (Logger, map[string]any) {
+	peerAddress := ""
+	if peer, ok := peer.FromContext(ctx); ok {
+		peerAddress = peer.Addr.String()
+	}
+
+	logInfo := map[string]any{
+		"debug_rpc_request": service.converter.encodeToJson(request, false),
+		"peer_addr":         peerAddress,
+		"service":           "flo_tg",
+		"method":            method,
+	}
+
+	logger := service.bootstrap.Logger.AddRequestID(fmt.Sprintf("rpc-%s", RandStringBytesMaskImprSrcSB(8)))
+	logger.Message(gelf.LOG_INFO, "rpc_service", method+"() from peer: "+peerAddress, logInfo)
+
+	return logger, logInfo
+}
+
+// All bits defined by proto.FLAGS
+func knownFlags() int32 {
+	var mask int32
+	for v := range proto.FLAGS_name {
+		mask |= v
+	}
+	return mask
+}
+
+// Request flags must have no unknown bits. Zero flags (clients before V1 was checked) are taken as V1.
+func checkRequestFlags(flags int32) error {
+	if flags != 0 && flags&int32(proto.FLAGS_V1) == 0 {
+		return errors.New("request flags must have V1 set")
+	}
+
+	if flags&^knownFlags() != 0 {
+		return errors.Errorf("request flags have unknown bits: %d", flags&^knownFlags())
+	}
+
+	return nil
+}
+
+// Each filter mask must be non-zero with no unknown bits
+func checkFilterFlags(filterFlags []int32) error {
+	for _, mask := range filterFlags {
+		if mask == 0 {
+			return errors.New("filter flags must not be zero")
+		}
+
+		if mask&^knownFlags() != 0 {
+			return errors.Errorf("filter flags have unknown bits: %d", mask&^knownFlags())
+		}
+	}
+
+	return nil
+}
+
+// Flags match when all bits of any filter mask are set, or there are no filter masks
+func matchFilterFlags(flags int32, filterFlags []int32) bool {
+	if len(filterFlags) == 0 {
+		return true
+	}
+
+	for _, mask := range filterFlags {
+		if flags&mask == mask {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ready reports status of each component, fails with Unavailable when any component is not serving.
+// Status of each component is in error details then (FlotgReadyResponse).
+func (service rpcService) Ready(ctx context.Context, request *emptypb.Empty) (*proto.FlotgReadyResponse, error) {
 	defer ctx.Done()
 
-	// TODO: check if queue is initialized and healthy, and telegram client is still running.
 	if !service.bootstrap.Queue.IsReady() {
-		return &emptypb.Empty{}, errors.New("not ready: queue")
+		service.bootstrap.Health.Set(health_queue, false, "not initialized or stopped")
+	}
+
+	response := service.bootstrap.Health.Ready()
+	if response.Ready {
+		return response, nil
+	}
+
+	notServing := []string{}
+	for _, c := range response.Components {
+		if !c.Serving {
+			notServing = append(notServing, fmt.Sprintf("%s (%s)", c.Name, c.Message))
+		}
 	}
 
-	return &emptypb.Empty{}, nil
+	st := status.New(codes.Unavailable, "not ready: "+strings.Join(notServing, ", "))
+	if detailed, err := st.WithDetails(response); err == nil {
+		st = detailed
+	}
+
+	return response, st.Err()
 }
 
 func (service rpcService) GetSources(request *proto.FlotgGetSourcesRequest, stream proto.FlotgService_GetSourcesServer) error {
@@ -151,34 +247,45 @@ func (service rpcService) GetSources(request *proto.FlotgGetSourcesRequest, stre
 
 	const method = "GetSources"
 
-	peerAddress := ""
-	if peer, ok := peer.FromContext(stream.Context()); ok {
-		peerAddress = peer.Addr.String()
-	}
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
 
-	logInfo := map[string]any{
-		"debug_rpc_request": service.converter.encodeToJson(request, false),
-		"peer_addr":         peerAddress,
-		"service":           "flo_tg",
-		"method":            method,
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
 	}
 
-	logger := service.bootstrap.Logger.AddRequestID(fmt.Sprintf("rpc-%s", RandStringBytesMaskImprSrcSB(8)))
-	logger.Message(gelf.LOG_INFO, "rpc_service", method+"() from peer: "+peerAddress, logInfo)
+	if err := checkFilterFlags(request.FilterFlags); err != nil {
+		return err
+	}
 
 	var result []storedSource
 	var err error
 
-	// TODO: request flags check
-	// FIXME: filter flags
+	limit := pageSize(request.PageSize)
 
-	op := func(ctx context.Context) {
-		read := storageRead{
-			storage: service.bootstrap.Storage,
-			logger:  logger,
+	query := sourcesQuery{
+		Uids:          request.SourceUids,
+		Limit:         limit + 1, // one more to know if there is a next page
+		MonitoredOnly: request.MonitoredOnly,
+		FilterFlags:   request.FilterFlags,
+
+		IncludeTitleHistory: request.IncludeTitleHistory,
+	}
+
+	if request.PageToken != "" {
+		token, err := decodePageToken(request.PageToken, page_token_kind_sources)
+		if err != nil {
+			logger.Message(gelf.LOG_WARNING, "rpc_service", "Bad page token", logInfo, map[string]any{
+				"err": err,
+			})
+			return err
 		}
+		query.AfterID = token.ID
+	}
 
-		result, err = read.Sources(ctx, request.SourceUids...)
+	op := func(ctx context.Context) {
+		read := service.bootstrap.Storage.Reader(logger)
+
+		result, err = read.Sources(ctx, query)
 	}
 
 	if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
@@ -196,6 +303,12 @@ func (service rpcService) GetSources(request *proto.FlotgGetSourcesRequest, stre
 		}
 	}
 
+	if int64(len(result)) > limit {
+		result = result[:limit]
+		token := newSourcesPageToken(result[len(result)-1])
+		stream.SetTrailer(metadata.Pairs(rpc_trailer_next_page_token, token.Encode()))
+	}
+
 	for i := range result {
 		err := stream.Send(result[i].Source)
 		if err != nil {
@@ -216,59 +329,165 @@ func (service rpcService) GetMessages(request *proto.FlotgGetMessagesRequest, st
 
 	const method = "GetMessages"
 
-	peerAddress := ""
-	if peer, ok := peer.FromContext(stream.Context()); ok {
-		peerAddress = peer.Addr.String()
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
 	}
 
-	logInfo := map[string]any{
-		"debug_rpc_request": service.converter.encodeToJson(request, false),
-		"peer_addr":         peerAddress,
-		"service":           "flo_tg",
-		"method":            method,
+	if err := checkFilterFlags(request.FilterFlags); err != nil {
+		return err
 	}
 
-	logger := service.bootstrap.Logger.AddRequestID(fmt.Sprintf("rpc-%s", RandStringBytesMaskImprSrcSB(8)))
-	logger.Message(gelf.LOG_INFO, "rpc_service", method+"() from peer: "+peerAddress, logInfo)
+	if request.MaxCount < 0 {
+		return errors.New("max_count must not be negative")
+	}
 
-	var result []storedMessage
-	var err error
+	if request.PageSize < 0 {
+		return errors.New("page_size must not be negative")
+	}
 
-	// TODO: request flags check
-	// FIXME: filter flags
+	if request.TopicId < 0 {
+		return errors.New("topic_id must not be negative")
+	}
 
-	op := func(ctx context.Context) {
-		read := storageRead{
-			storage: service.bootstrap.Storage,
-			logger:  logger,
-		}
+	// Without page size, all messages are streamed: they are read in pages of server default size
+	paged := request.PageSize > 0
+	pageLimit := pageSize(request.PageSize)
+	maxCount := int64(request.MaxCount)
+
+	query := messagesQuery{
+		SourceUid:   request.SourceUid,
+		Descending:  request.SortOrder == proto.FlotgSortOrder_SortDescending,
+		FilterFlags: request.FilterFlags,
+		TopicID:     request.TopicId,
+		AuthorUid:   request.AuthorUid,
 
-		result, err = read.Messages(stream.Context(), request.SourceUid)
+		IncludeRevisions: request.IncludeRevisions,
+		Deleted:          request.Deleted,
 	}
 
-	if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
-		logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
-		return errors.New("queue is busy, try again")
+	if request.PageToken != "" {
+		token, err := decodePageToken(request.PageToken, page_token_kind_messages)
+		if err == nil {
+			query.After, err = token.MessagesCursor(query)
+		}
+		if err != nil {
+			logger.Message(gelf.LOG_WARNING, "rpc_service", "Bad page token", logInfo, map[string]any{
+				"err": err,
+			})
+			return err
+		}
 	}
 
-	if err != nil {
-		logger.Message(gelf.LOG_ERR, "rpc_service", "storage_read.Messages fail", logInfo, map[string]any{
-			"err":               err,
-			"debug_rpc_request": service.converter.encodeToJson(request, false),
-		})
-		if result == nil {
+	// General topic also has messages without topic id, only in forums
+	if request.TopicId == 1 {
+		var sources []storedSource
+		var err error
+
+		op := func(ctx context.Context) {
+			read := service.bootstrap.Storage.Reader(logger)
+
+			sources, err = read.Sources(stream.Context(), sourcesQuery{Uids: []string{request.SourceUid}})
+		}
+
+		if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+			logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+			return errors.New("queue is busy, try again")
+		}
+
+		if err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "storage_read.Sources fail", logInfo, map[string]any{
+				"err": err,
+			})
 			return errors.New("storage read operation failed on backend")
 		}
+
+		query.Forum = len(sources) > 0 && sources[0].Source.Flags&int32(proto.FLAGS_Forum) != 0
 	}
 
-	for i := range result {
-		err := stream.Send(result[i].Message)
+	if request.MessagesSince != nil {
+		query.Since = request.MessagesSince.AsTime()
+	}
+
+	if request.MessagesBefore != nil {
+		query.Before = request.MessagesBefore.AsTime()
+	}
+
+	sent := int64(0)
+
+	for {
+		limit := pageLimit
+		if maxCount > 0 && maxCount-sent < limit {
+			limit = maxCount - sent
+		}
+
+		query.Limit = limit + 1 // one more to know if there are more messages
+
+		var result []storedMessage
+		var err error
+
+		op := func(ctx context.Context) {
+			read := service.bootstrap.Storage.Reader(logger)
+
+			result, err = read.Messages(stream.Context(), query)
+		}
+
+		if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+			logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+			return errors.New("queue is busy, try again")
+		}
+
 		if err != nil {
-			logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
-				"err": err,
+			logger.Message(gelf.LOG_ERR, "rpc_service", "storage_read.Messages fail", logInfo, map[string]any{
+				"err":               err,
+				"debug_rpc_request": service.converter.encodeToJson(request, false),
 			})
-			return errors.New("streaming failed on backend")
+			if result == nil {
+				return errors.New("storage read operation failed on backend")
+			}
+		}
+
+		more := int64(len(result)) > limit
+		if more {
+			result = result[:limit]
+		}
+
+		for i := range result {
+			message := result[i].Message
+
+			if request.IncludeMarkdown {
+				message.TextMarkdown = renderMarkdown(message.Text, message.Entities)
+			}
+
+			if request.IncludeHtml {
+				message.TextHtml = renderHTML(message.Text, message.Entities)
+			}
+
+			err := stream.Send(message)
+			if err != nil {
+				logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+					"err": err,
+				})
+				return errors.New("streaming failed on backend")
+			}
+		}
+
+		sent += int64(len(result))
+
+		// max_count is reached, no page token
+		if !more || (maxCount > 0 && sent >= maxCount) {
+			break
 		}
+
+		token := newMessagesPageToken(query, result[len(result)-1])
+
+		if paged {
+			stream.SetTrailer(metadata.Pairs(rpc_trailer_next_page_token, token.Encode()))
+			break
+		}
+
+		query.After, _ = token.MessagesCursor(query)
 	}
 
 	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+func (service rpcService) EnableMonitoring(ctx context.Context, request *proto.FlotgSourceRequest) (*proto.FLO_SOURCE, error) {
+	return service.setMonitoring(ctx, "EnableMonitoring", request, true)
+}
+
+func (service rpcService) DisableMonitoring(ctx context.Context, request *proto.FlotgSourceRequest) (*proto.FLO_SOURCE, error) {
+	return service.setMonitoring(ctx, "DisableMonitoring", request, false)
+}
+
+func (service rpcService) setMonitoring(ctx context.Context, method string, request *proto.FlotgSourceRequest, monitored bool) (*proto.FLO_SOURCE, error) {
+	logger, logInfo := service.requestLogger(ctx, method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return nil, err
+	}
+
+	if request.SourceUid == "" {
+		return nil, errors.New("source_uid is required")
+	}
+
+	var result []storedSource
+	var err error
+
+	op := func(ctx context.Context) {
+		save := service.bootstrap.Storage.Saver(logger)
+
+		if err = save.Monitoring(ctx, request.SourceUid, monitored); err != nil {
+			return
+		}
+
+		read := service.bootstrap.Storage.Reader(logger)
+
+		result, err = read.Sources(ctx, sourcesQuery{Uids: []string{request.SourceUid}})
+	}
+
+	if !service.bootstrap.Queue.Join(ctx, time.Second*5, op) {
+		logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+		return nil, errors.New("queue is busy, try again")
+	}
+
+	if err == errSourceNotFound || (err == nil && len(result) == 0) {
+		return nil, errors.New("source not found")
+	}
+
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "storage monitoring change fail", logInfo, map[string]any{
+			"err": err,
+		})
+		return nil, errors.New("storage operation failed on backend")
+	}
+
+	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
+
+	return result[0].Source, nil
+}
+
+func (service rpcService) GetMonitoredSources(request *emptypb.Empty, stream proto.FlotgService_GetMonitoredSourcesServer) error {
+	defer stream.Context().Done()
+
+	const method = "GetMonitoredSources"
+
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	var result []storedSource
+	var err error
+
+	op := func(ctx context.Context) {
+		read := service.bootstrap.Storage.Reader(logger)
+
+		result, err = read.Sources(ctx, sourcesQuery{MonitoredOnly: true})
+	}
+
+	if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+		logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+		return errors.New("queue is busy, try again")
+	}
+
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "storage_read.Sources fail", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("storage read operation failed on backend")
+	}
+
+	for i := range result {
+		err := stream.Send(result[i].Source)
+		if err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+				"err": err,
+			})
+			return errors.New("streaming failed on backend")
+		}
+	}
+
+	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/pkg/errors"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+const feed_subscriber_backlog = 1000
+
+func (service rpcService) StreamMessages(request *proto.FlotgStreamMessagesRequest, stream proto.FlotgService_StreamMessagesServer) error {
+	defer stream.Context().Done()
+
+	const method = "StreamMessages"
+
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
+	}
+
+	// Subscribe before replay, so messages saved during replay are not missed
+	sub := service.bootstrap.Feed.Subscribe(request.SourceUids...)
+	defer service.bootstrap.Feed.Unsubscribe(sub)
+
+	// Keys of replayed messages, kept until messages published during replay are received
+	var replayed map[string]bool
+	catchUp := 0
+
+	// Messages published during replay, a long replay would fill subscription backlog and the subscriber would be dropped
+	var held []*proto.FLO_MESSAGE
+
+	if request.ReplaySince != nil {
+		release := sub.Hold()
+
+		var err error
+		replayed, err = service.replayMessages(stream, logger, request.SourceUids, request.ReplaySince.AsTime())
+		held = release()
+		if err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "Replay failed", logInfo, map[string]any{
+				"err": err,
+			})
+			return err
+		}
+
+		logInfo["replayed"] = len(replayed)
+		logInfo["held"] = len(held)
+		logger.Message(gelf.LOG_DEBUG, "rpc_service", "Replay completed, streaming new messages", logInfo)
+
+		// Messages published during replay are held or buffered, messages published after were not replayed
+		catchUp = len(held) + len(sub.Messages)
+		if catchUp == 0 {
+			replayed = nil
+		}
+	}
+
+	send := func(message *proto.FLO_MESSAGE) error {
+		if replayed != nil {
+			seen := replayed[feedMessageKey(message)]
+
+			catchUp--
+			if catchUp == 0 {
+				replayed = nil
+			}
+
+			if seen {
+				return nil
+			}
+		}
+
+		if err := stream.Send(message); err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+				"err": err,
+			})
+			return errors.New("streaming failed on backend")
+		}
+
+		return nil
+	}
+
+	for _, message := range held {
+		if err := send(message); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
+			return nil
+
+		case message, ok := <-sub.Messages:
+			if !ok {
+				if service.bootstrap.Feed.Dropped(sub) {
+					logger.Message(gelf.LOG_WARNING, "rpc_service", "Subscriber dropped, it was too slow to receive messages", logInfo)
+					return errors.New("stream is too slow, messages were lost")
+				}
+				return nil
+			}
+
+			if err := send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Send stored messages since given time, page by page for each source.
+// Returns keys of sent messages (see feedMessageKey).
+func (service rpcService) replayMessages(stream proto.FlotgService_StreamMessagesServer, logger Logger, sourceUids []string, since time.Time) (map[string]bool, error) {
+	read := service.bootstrap.Storage.Reader(logger)
+
+	var err error
+
+	if len(sourceUids) == 0 {
+		var sources []storedSource
+
+		op := func(ctx context.Context) {
+			sources, err = read.Sources(ctx, sourcesQuery{MonitoredOnly: true})
+		}
+
+		if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+			return nil, errors.New("queue is busy, try again")
+		}
+		if err != nil {
+			return nil, errors.New("storage read operation failed on backend")
+		}
+
+		for i := range sources {
+			sourceUids = append(sourceUids, sources[i].ID)
+		}
+	}
+
+	replayed := map[string]bool{}
+
+	for _, sourceUid := range sourceUids {
+		query := messagesQuery{
+			SourceUid: sourceUid,
+			Since:     since,
+			Limit:     rpc_page_size_max,
+		}
+
+		for {
+			var result []storedMessage
+
+			op := func(ctx context.Context) {
+				result, err = read.Messages(ctx, query)
+			}
+
+			if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+				return nil, errors.New("queue is busy, try again")
+			}
+			if err != nil {
+				return nil, errors.New("storage read operation failed on backend")
+			}
+
+			for i := range result {
+				if err := stream.Send(result[i].Message); err != nil {
+					return nil, errors.New("streaming failed on backend")
+				}
+				replayed[feedMessageKey(result[i].Message)] = true
+			}
+
+			if int64(len(result)) < query.Limit {
+				break
+			}
+
+			last := result[len(result)-1]
+			query.After = &messagesCursor{
+				CreatedAt: last.MessageCreatedAt.Time(),
+				ID:        last.ID,
+			}
+		}
+	}
+
+	return replayed, nil
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testMessagesStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   []*proto.FLO_MESSAGE
+	onSend func(message *proto.FLO_MESSAGE)
+}
+
+func (stream *testMessagesStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *testMessagesStream) Send(message *proto.FLO_MESSAGE) error {
+	stream.sent = append(stream.sent, message)
+	if stream.onSend != nil {
+		stream.onSend(message)
+	}
+	return nil
+}
+
+// Wait before every subscriber received messages published to it (channel is empty), for a second at most
+func waitFeedReceived(feed *Feed) {
+	for i := 0; i < 1000; i++ {
+		feed.mu.Lock()
+		pending := 0
+		for sub := range feed.subscribers {
+			pending += len(sub.messages)
+		}
+		feed.mu.Unlock()
+
+		if pending == 0 {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func testMessage(sourceUid string, id int, createdAt time.Time) *proto.FLO_MESSAGE {
+	return &proto.FLO_MESSAGE{
+		Flags:      int32(proto.FLAGS_V1 | proto.FLAGS_Tg | proto.FLAGS_Channel),
+		SourceUid:  sourceUid,
+		MessageUid: makeMessageUid(sourceUid, id),
+		CreatedAt:  timestamppb.New(createdAt),
+		Text:       fmt.Sprintf("message %d", id),
+	}
+}
+
+// Messages published during a replay longer than subscriber backlog are streamed after replay, subscriber is not dropped
+func TestStreamMessagesReplayLongerThanBacklog(t *testing.T) {
+	const backlog = 4
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	health := NewHealth()
+
+	// Queue is stopped with the test context
+	queue := NewQueue(10)
+	queue.Initialize(ctx)
+	go queue.Run()
+
+	bootstrap := Bootstrap{
+		Storage: NewStorageMemory(health),
+		Logger:  dummyLogging{},
+		Queue:   queue,
+		Feed:    NewFeed(backlog),
+		Health:  health,
+	}
+
+	service := rpcService{
+		bootstrap: bootstrap,
+		converter: newConverter(bootstrap, nil),
+	}
+
+	sourceUid := makeSourceUid(100)
+	source := &proto.FLO_SOURCE{
+		Flags:     int32(proto.FLAGS_V1 | proto.FLAGS_Tg | proto.FLAGS_Channel),
+		SourceUid: sourceUid,
+		Monitored: true,
+	}
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	save := bootstrap.Storage.Saver(bootstrap.Logger)
+	stored := 3
+	for id := 1; id <= stored; id++ {
+		if _, err := save.Message(ctx, service.converter, source, testMessage(sourceUid, id, start.Add(time.Duration(id)*time.Minute))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// New messages are saved while the first stored message is replayed, more than subscriber backlog.
+	// A replayed message is published again, it is not sent twice.
+	published := backlog * 3
+	expected := stored + published
+
+	stream := &testMessagesStream{ctx: ctx}
+	stream.onSend = func(message *proto.FLO_MESSAGE) {
+		if len(stream.sent) == expected {
+			cancel()
+		}
+		if len(stream.sent) != 1 {
+			return
+		}
+
+		bootstrap.Feed.Publish(testMessage(sourceUid, 1, start.Add(time.Minute)))
+		waitFeedReceived(bootstrap.Feed)
+
+		for id := stored + 1; id <= stored+published; id++ {
+			bootstrap.Feed.Publish(testMessage(sourceUid, id, start.Add(time.Duration(id)*time.Minute)))
+			if id%(backlog-1) == 0 {
+				waitFeedReceived(bootstrap.Feed)
+			}
+		}
+		waitFeedReceived(bootstrap.Feed)
+	}
+
+	request := &proto.FlotgStreamMessagesRequest{
+		SourceUids:  []string{sourceUid},
+		ReplaySince: timestamppb.New(start),
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- service.StreamMessages(request, stream)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("StreamMessages failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("StreamMessages did not complete")
+	}
+
+	if len(stream.sent) != expected {
+		t.Fatalf("sent %d messages, expected %d", len(stream.sent), expected)
+	}
+
+	for i, message := range stream.sent {
+		if uid := makeMessageUid(sourceUid, i+1); message.MessageUid != uid {
+			t.Errorf("message %d is %s, expected %s", i, message.MessageUid, uid)
+		}
+	}
+}
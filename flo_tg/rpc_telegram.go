@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/pkg/errors"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+func (service rpcService) BackfillSource(request *proto.FlotgBackfillRequest, stream proto.FlotgService_BackfillSourceServer) error {
+	defer stream.Context().Done()
+
+	const method = "BackfillSource"
+
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
+	}
+
+	if request.SourceUid == "" {
+		return errors.New("source_uid is required")
+	}
+
+	if request.MaxMessages < 0 {
+		return errors.New("max_messages must not be negative")
+	}
+
+	api, handling, ok := service.bootstrap.Telegram.Get()
+	if !ok {
+		return errors.New("telegram client is not running")
+	}
+
+	unlock, ok := service.bootstrap.Telegram.Lock("backfill/" + request.SourceUid)
+	if !ok {
+		return errors.New("backfill is already running for this source")
+	}
+	defer unlock()
+
+	var sources []storedSource
+	var state *storedBackfill
+	var err error
+
+	op := func(ctx context.Context) {
+		read := service.bootstrap.Storage.Reader(logger)
+
+		sources, err = read.Sources(ctx, sourcesQuery{Uids: []string{request.SourceUid}})
+		if err != nil || request.Restart {
+			return
+		}
+
+		state, err = read.Backfill(ctx, request.SourceUid)
+	}
+
+	if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+		logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+		return errors.New("queue is busy, try again")
+	}
+
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "storage read fail", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("storage read operation failed on backend")
+	}
+
+	if len(sources) == 0 {
+		return errors.New("source not found")
+	}
+
+	if !sources[0].Monitored {
+		return errors.New("source is not monitored, turn monitoring on first")
+	}
+
+	if state == nil {
+		state = &storedBackfill{ID: request.SourceUid}
+	}
+
+	peer, err := handling.sourcePeer(stream.Context(), sources[0].Source)
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "Source peer not found", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("source peer not found in telegram peer storage")
+	}
+
+	progress := func(state *storedBackfill) error {
+		err := stream.Send(&proto.FlotgBackfillProgress{
+			Flags:           int32(proto.FLAGS_V1) | int32(proto.FLAGS_Tg),
+			SourceUid:       state.ID,
+			Processed:       int32(state.Processed),
+			Total:           int32(state.Total),
+			OffsetMessageId: int64(state.OffsetID),
+			Done:            state.Done,
+		})
+		if err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+				"err": err,
+			})
+			return errors.New("streaming failed on backend")
+		}
+		return nil
+	}
+
+	// Already done, report saved progress
+	if state.Done {
+		return progress(state)
+	}
+
+	if err := handling.Backfill(stream.Context(), api, peer, state, int(request.MaxMessages), progress); err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "Backfill failed", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("backfill failed on backend, progress is saved to resume")
+	}
+
+	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
+
+	return nil
+}
+
+func (service rpcService) ListDialogs(request *proto.FlotgListDialogsRequest, stream proto.FlotgService_ListDialogsServer) error {
+	defer stream.Context().Done()
+
+	const method = "ListDialogs"
+
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
+	}
+
+	if err := checkFilterFlags(request.FilterFlags); err != nil {
+		return err
+	}
+
+	api, handling, ok := service.bootstrap.Telegram.Get()
+	if !ok {
+		return errors.New("telegram client is not running")
+	}
+
+	dialogs, err := handling.ListDialogs(stream.Context(), api)
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "ListDialogs failed", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("telegram dialogs request failed on backend")
+	}
+
+	logInfo["dialogs"] = len(dialogs)
+
+	var sources []storedSource
+
+	// Dialogs are saved as sources (existing are kept), so monitoring can be turned on for them
+	op := func(ctx context.Context) {
+		save := service.bootstrap.Storage.Saver(logger)
+
+		uids := make([]string, 0, len(dialogs))
+
+		for _, dialog := range dialogs {
+			dialog.Source.Monitored = service.bootstrap.MonitorNewSources
+
+			if _, err = save.Source(ctx, service.converter, dialog.Source); err != nil {
+				return
+			}
+
+			uids = append(uids, dialog.Source.SourceUid)
+		}
+
+		read := service.bootstrap.Storage.Reader(logger)
+
+		sources, err = read.Sources(ctx, sourcesQuery{Uids: uids})
+	}
+
+	if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+		logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+		return errors.New("queue is busy, try again")
+	}
+
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "storage dialog sources fail", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("storage operation failed on backend")
+	}
+
+	monitored := make(map[string]bool, len(sources))
+	for i := range sources {
+		monitored[sources[i].ID] = sources[i].Monitored
+	}
+
+	for _, dialog := range dialogs {
+		if !matchFilterFlags(dialog.Source.Flags, request.FilterFlags) {
+			continue
+		}
+
+		dialog.Source.Monitored = monitored[dialog.Source.SourceUid]
+
+		if err := stream.Send(dialog); err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+				"err": err,
+			})
+			return errors.New("streaming failed on backend")
+		}
+	}
+
+	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
+
+	return nil
+}
+
+func (service rpcService) ListTopics(request *proto.FlotgSourceRequest, stream proto.FlotgService_ListTopicsServer) error {
+	defer stream.Context().Done()
+
+	const method = "ListTopics"
+
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
+	}
+
+	if request.SourceUid == "" {
+		return errors.New("source_uid is required")
+	}
+
+	api, handling, ok := service.bootstrap.Telegram.Get()
+	if !ok {
+		return errors.New("telegram client is not running")
+	}
+
+	var sources []storedSource
+	var err error
+
+	op := func(ctx context.Context) {
+		read := service.bootstrap.Storage.Reader(logger)
+
+		sources, err = read.Sources(ctx, sourcesQuery{Uids: []string{request.SourceUid}})
+	}
+
+	if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+		logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+		return errors.New("queue is busy, try again")
+	}
+
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "storage read fail", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("storage read operation failed on backend")
+	}
+
+	if len(sources) == 0 {
+		return errors.New("source not found")
+	}
+
+	if sources[0].Source.Flags&int32(proto.FLAGS_Channel) == 0 {
+		return errors.New("source is not a forum supergroup")
+	}
+
+	peer, err := handling.sourcePeer(stream.Context(), sources[0].Source)
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "Source peer not found", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("source peer not found in telegram peer storage")
+	}
+
+	if peer.Channel == nil || !peer.Channel.Forum {
+		return errors.New("source is not a forum supergroup")
+	}
+
+	topics, err := handling.ListTopics(stream.Context(), api, peer, request.SourceUid)
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "ListTopics failed", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("telegram topics request failed on backend")
+	}
+
+	for _, topic := range topics {
+		if err := stream.Send(topic); err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+				"err": err,
+			})
+			return errors.New("streaming failed on backend")
+		}
+	}
+
+	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
+
+	return nil
+}
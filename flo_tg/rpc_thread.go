@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/pkg/errors"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+// Ancestors of a message are followed up to this depth
+const thread_max_depth = 100
+
+func (service rpcService) GetThread(request *proto.FlotgThreadRequest, stream proto.FlotgService_GetThreadServer) error {
+	defer stream.Context().Done()
+
+	const method = "GetThread"
+
+	logger, logInfo := service.requestLogger(stream.Context(), method, request)
+
+	if err := checkRequestFlags(request.Flags); err != nil {
+		return err
+	}
+
+	if request.SourceUid == "" || request.MessageUid == "" {
+		return errors.New("source_uid and message_uid are required")
+	}
+
+	if request.MaxReplies < 0 {
+		return errors.New("max_replies must not be negative")
+	}
+
+	limit := pageSize(request.MaxReplies)
+
+	var message *storedMessage
+	var ancestors, replies []storedMessage
+	var err error
+
+	op := func(ctx context.Context) {
+		read := service.bootstrap.Storage.Reader(logger)
+
+		message, err = read.Message(ctx, request.SourceUid, request.MessageUid)
+		if err != nil || message == nil {
+			return
+		}
+
+		// Replied messages, until the root or a message that is not stored (or of another source)
+		parent := message.Message.ReplyToMessageUid
+		for depth := 0; parent != "" && depth < thread_max_depth; depth++ {
+			var m *storedMessage
+			if m, err = read.Message(ctx, request.SourceUid, parent); err != nil {
+				return
+			}
+			if m == nil {
+				break
+			}
+
+			ancestors = append([]storedMessage{*m}, ancestors...)
+			parent = m.Message.ReplyToMessageUid
+		}
+
+		// Replies to the message and to its replies, breadth first
+		frontier := []string{message.ID}
+		for len(frontier) > 0 && int64(len(replies)) < limit {
+			var found []storedMessage
+			if found, err = read.Replies(ctx, request.SourceUid, frontier, limit-int64(len(replies))); err != nil {
+				return
+			}
+
+			frontier = nil
+			for _, m := range found {
+				replies = append(replies, m)
+				frontier = append(frontier, m.ID)
+			}
+		}
+	}
+
+	if !service.bootstrap.Queue.Join(stream.Context(), time.Second*5, op) {
+		logger.Message(gelf.LOG_WARNING, "rpc_service", "Queue join did not run", logInfo)
+		return errors.New("queue is busy, try again")
+	}
+
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "rpc_service", "storage thread read fail", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.New("storage read operation failed on backend")
+	}
+
+	if message == nil {
+		return errors.New("message not found")
+	}
+
+	sort.SliceStable(replies, func(i, j int) bool {
+		if replies[i].MessageCreatedAt != replies[j].MessageCreatedAt {
+			return replies[i].MessageCreatedAt < replies[j].MessageCreatedAt
+		}
+		return replies[i].ID < replies[j].ID
+	})
+
+	thread := append(append(ancestors, *message), replies...)
+
+	for i := range thread {
+		err := stream.Send(thread[i].Message)
+		if err != nil {
+			logger.Message(gelf.LOG_ERR, "rpc_service", "gRPC Stream.Send() fail", logInfo, map[string]any{
+				"err": err,
+			})
+			return errors.New("streaming failed on backend")
+		}
+	}
+
+	logInfo["ancestors"] = len(ancestors)
+	logInfo["replies"] = len(replies)
+
+	logger.Message(gelf.LOG_DEBUG, "rpc_service", "Request "+method+" completed", logInfo)
+
+	return nil
+}
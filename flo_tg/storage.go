@@ -2,52 +2,197 @@ package main
 
 import (
 	"context"
+	"time"
 
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/go-faster/errors"
+	protobuf_proto "github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/Graylog2/go-gelf.v2/gelf"
 )
 
-// TODO: remove (unused)
+// Id of saved source or message (source uid or message uid)
 type StorageObjectID string
 
 const (
-	db_collection_sources           = "tgv1-sources"
+	storage_mongo  = "mongo"
+	storage_sqlite = "sqlite"
+	storage_memory = "memory"
+
 	STORAGE_BINARY_RPC_SUBTYPE byte = 255 // 0xff
+
+	storage_ping_interval = 30 * time.Second
 )
 
-type Storage struct {
-	logger   Logger
-	mgClient *mongo.Client
-	dbName   string
+var errSourceNotFound = errors.New("source not found")
+
+// Storage keeps sources, messages and backfill progress (MongoDB, SQLite or in-memory).
+// Storage operations are run with the Queue, reader and saver are made for each operation with its logger.
+type Storage interface {
+	Reader(logger Logger) StorageReader
+	Saver(logger Logger) StorageSaver
+
+	// Check storage is available, result is reported as storage health
+	Ping() error
+
+	Close()
+}
+
+type StorageReader interface {
+	Sources(ctx context.Context, query sourcesQuery) ([]storedSource, error)
+
+	// Monitoring state of a stored source. Not found source is not monitored.
+	SourceMonitored(ctx context.Context, uid string) (bool, error)
+
+	// Saved backfill progress of a source, nil if not found
+	Backfill(ctx context.Context, sourceUid string) (*storedBackfill, error)
+
+	Messages(ctx context.Context, query messagesQuery) ([]storedMessage, error)
+
+	// Stored message by uid, nil if not found
+	Message(ctx context.Context, sourceUid, messageUid string) (*storedMessage, error)
+
+	// Stored replies to any of the messages, oldest first
+	Replies(ctx context.Context, sourceUid string, messageUids []string, limit int64) ([]storedMessage, error)
+}
+
+type StorageSaver interface {
+	// Save source, or update stored source when its title, username or flags changed.
+	// Previous title is kept in title history. Monitoring state of a stored source is not changed.
+	Source(ctx context.Context, c *converter, source *proto.FLO_SOURCE) (StorageObjectID, error)
+
+	// Turn monitoring on or off for a stored source.
+	// Returns errSourceNotFound if source is not stored.
+	Monitoring(ctx context.Context, uid string, monitored bool) error
+
+	// Save new message, stored message is not changed
+	Message(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error)
+
+	// Save new message like Message, stored message is not changed.
+	// MongoDB storage may keep the message pending, and insert it later with other new messages of its collection.
+	// [saved] (optional) is called once the message is stored or found stored already, in order messages were saved.
+	MessageBatched(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE, saved func()) error
+
+	// Save edited message as a new revision of the stored message.
+	// Previous version is kept in revisions. A message not stored yet is saved as new message.
+	MessageEdit(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error)
+
+	// Mark stored messages of the source with telegram message ids as deleted in telegram. Messages are kept, deleted_at and Deleted flag are set.
+	// Without source uid, messages of all user and group sources are marked: their message ids are unique per account.
+	// Returns number of messages marked, not stored or already deleted messages are not counted.
+	MessagesDeleted(ctx context.Context, sourceUid string, messageIDs []int, deletedAt time.Time) (int64, error)
+
+	// Save backfill progress of a source (insert or replace)
+	Backfill(ctx context.Context, state *storedBackfill) error
+}
+
+// Goroutine that pings storage every [interval] until context is done
+func RunStoragePing(ctx context.Context, storage Storage, logger Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := storage.Ping(); err != nil {
+				logger.Message(gelf.LOG_ERR, "storage", "Ping storage failed", map[string]any{
+					"err": err,
+				})
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
-func NewStorageMongo(uri string, databaseName string, logger Logger) *Storage {
+// Compare seen source with the stored one, before the stored source is updated.
+// Source seen without a title (e.g. min peer info) keeps the stored title, monitoring state of the stored source is kept.
+func sourceChanges(stored *storedSource, source *proto.FLO_SOURCE) (changed, titleChanged bool) {
+	// Saved before canonical title was tracked
+	if stored.CanonicalTitle == "" {
+		stored.CanonicalTitle = stored.Source.Title
+	}
+
+	if source.Title == "" {
+		source.Title = stored.CanonicalTitle
+	}
 
-	serverAPI := options.ServerAPI(options.ServerAPIVersion1)
-	opts := options.Client().ApplyURI(uri).SetServerAPIOptions(serverAPI)
+	source.Monitored = stored.Monitored
 
-	client, err := mongo.Connect(context.TODO(), opts)
-	if err != nil {
-		panic(err)
+	titleChanged = source.Title != stored.CanonicalTitle
+	changed = titleChanged || source.Username != stored.Source.Username || source.Flags != stored.Source.Flags
+
+	return changed, titleChanged
+}
+
+// Title history entry for the stored title replaced at [now]
+func sourcePreviousTitle(stored *storedSource, now primitive.DateTime) storedTitle {
+	since := stored.TitleSince
+	if since == 0 {
+		since = stored.CreatedAt
 	}
 
-	return &Storage{
-		mgClient: client,
-		dbName:   databaseName,
+	return storedTitle{
+		Title: stored.CanonicalTitle,
+		Since: since,
+		Until: now,
 	}
 }
 
-func (storage *Storage) Ping() error {
-	result := &bson.M{}
-	return storage.mgClient.Database(storage.dbName).RunCommand(context.TODO(), bson.D{{"ping", 1}}).Decode(&result)
+// Fill source read from storage: monitoring state, and title history on request
+func storedSourceResult(m *storedSource, includeTitleHistory bool) {
+	m.Source.Monitored = m.Monitored
+
+	if includeTitleHistory {
+		for _, t := range m.TitleHistory {
+			m.Source.TitleHistory = append(m.Source.TitleHistory, &proto.FLO_SOURCE_TITLE{
+				Title: t.Title,
+				Since: timestamppb.New(t.Since.Time()),
+				Until: timestamppb.New(t.Until.Time()),
+			})
+		}
+	}
 }
 
-func (storage *Storage) Close() {
-	if err := storage.mgClient.Disconnect(context.TODO()); err != nil {
-		storage.logger.Message(gelf.LOG_WARNING, "storage", "ERROR Close() mongodb connection", map[string]any{
-			"err": err,
+// Fill message read from storage: deletion state, and revisions on request
+func storedMessageResult(logger Logger, m *storedMessage, includeRevisions bool) {
+	// Stored RPC is the last received version, deletion is only recorded in the document
+	if m.DeletedAt != 0 {
+		m.Message.DeletedAt = timestamppb.New(m.DeletedAt.Time())
+		m.Message.Flags |= int32(proto.FLAGS_Deleted)
+	}
+
+	if includeRevisions {
+		storedMessageRevisions(logger, m)
+	}
+}
+
+// Fill message revisions from stored previous versions and the current one
+func storedMessageRevisions(logger Logger, m *storedMessage) {
+	message := m.Message
+
+	message.Revisions = nil
+
+	for _, r := range m.Revisions {
+		previous := &proto.FLO_MESSAGE{}
+
+		if err := protobuf_proto.Unmarshal(r.MessageRPC.Data, previous); err != nil {
+			logger.Message(gelf.LOG_ERR, "storage_read", "Unmarshal RPC failed for revision (skipped)", map[string]any{
+				"id":  m.ID,
+				"err": err,
+			})
+			continue
+		}
+
+		message.Revisions = append(message.Revisions, &proto.FLO_MESSAGE_REVISION{
+			EditedAt: previous.EditedAt,
+			Text:     previous.Text,
 		})
 	}
+
+	message.Revisions = append(message.Revisions, &proto.FLO_MESSAGE_REVISION{
+		EditedAt: message.EditedAt,
+		Text:     message.Text,
+	})
 }
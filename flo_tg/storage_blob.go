@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	blob_store_gridfs = "gridfs"
+	blob_store_dir    = "dir"
+	blob_store_none   = "none"
+
+	db_bucket_attachments = "tgv1-attachments"
+)
+
+var errBlobNotFound = errors.New("blob not found")
+
+// BlobStore keeps downloaded attachment files by attachment uid.
+// Blobs are large and read or written for a long time, so blob store is used without the Queue.
+type BlobStore interface {
+	// Tells if blob is stored
+	Exists(ctx context.Context, uid string) (bool, error)
+
+	// Stores blob read from r until EOF. Blob is not stored if r returns an error.
+	Put(ctx context.Context, uid string, r io.Reader) error
+
+	// Opens stored blob for reading, with its size. Returns errBlobNotFound if blob is not stored.
+	Open(ctx context.Context, uid string) (io.ReadCloser, int64, error)
+}
+
+// Blob store in GridFS bucket of flo_tg database (default)
+type blobStoreGridFS struct {
+	bucket *gridfs.Bucket
+}
+
+func NewBlobStoreGridFS(storage *storageMongo) (BlobStore, error) {
+	db := storage.mgClient.Database(storage.dbName)
+
+	bucket, err := gridfs.NewBucket(db, options.GridFSBucket().SetName(db_bucket_attachments))
+	if err != nil {
+		return nil, errors.Wrap(err, "gridfs.NewBucket")
+	}
+
+	return &blobStoreGridFS{bucket: bucket}, nil
+}
+
+func (store *blobStoreGridFS) Exists(ctx context.Context, uid string) (bool, error) {
+	n, err := store.bucket.GetFilesCollection().CountDocuments(ctx, bson.D{{Key: "_id", Value: uid}}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, errors.Wrap(err, "CountDocuments failed (GridFS files)")
+	}
+	return n > 0, nil
+}
+
+func (store *blobStoreGridFS) Put(ctx context.Context, uid string, r io.Reader) error {
+	// Partially uploaded file is aborted (chunks removed) by GridFS on read error
+	return store.bucket.UploadFromStreamWithID(uid, uid, r)
+}
+
+func (store *blobStoreGridFS) Open(ctx context.Context, uid string) (io.ReadCloser, int64, error) {
+	stream, err := store.bucket.OpenDownloadStream(uid)
+	if err == gridfs.ErrFileNotFound {
+		return nil, 0, errBlobNotFound
+	} else if err != nil {
+		return nil, 0, errors.Wrap(err, "OpenDownloadStream failed (GridFS)")
+	}
+	return stream, stream.GetFile().Length, nil
+}
+
+// Blob store in a local directory, one file per blob
+type blobStoreDir struct {
+	dir string
+}
+
+func NewBlobStoreDir(dir string) (BlobStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "Error mkdir (0700) for path "+dir)
+	}
+
+	return &blobStoreDir{dir: dir}, nil
+}
+
+// Path of blob file, uid must not be a path
+func (store *blobStoreDir) path(uid string) (string, error) {
+	if uid == "" || strings.ContainsAny(uid, `/\.`) {
+		return "", errBlobNotFound
+	}
+	return filepath.Join(store.dir, uid), nil
+}
+
+func (store *blobStoreDir) Exists(ctx context.Context, uid string) (bool, error) {
+	path, err := store.path(uid)
+	if err != nil {
+		return false, nil
+	}
+
+	_, err = os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (store *blobStoreDir) Put(ctx context.Context, uid string, r io.Reader) error {
+	path, err := store.path(uid)
+	if err != nil {
+		return err
+	}
+
+	// Written to temporary file first, so a partial file is never seen as stored
+	tmp, err := os.CreateTemp(store.dir, ".put-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (store *blobStoreDir) Open(ctx context.Context, uid string) (io.ReadCloser, int64, error) {
+	path, err := store.path(uid)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, 0, errBlobNotFound
+	} else if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+
+	return f, info.Size(), nil
+}
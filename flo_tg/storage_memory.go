@@ -0,0 +1,517 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	protobuf_proto "github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+// Storage in process memory, for demos and integration tests without a database. Nothing is kept after exit.
+// Stored documents are copies, so callers never share messages or sources with the storage.
+type storageMemory struct {
+	mu       sync.Mutex
+	health   *Health
+	sources  map[string]*storedSource
+	messages map[string]map[string]*storedMessage // by source uid, then by message uid
+	backfill map[string]*storedBackfill
+}
+
+func NewStorageMemory(health *Health) *storageMemory {
+	return &storageMemory{
+		health:   health,
+		sources:  map[string]*storedSource{},
+		messages: map[string]map[string]*storedMessage{},
+		backfill: map[string]*storedBackfill{},
+	}
+}
+
+func (storage *storageMemory) Reader(logger Logger) StorageReader {
+	return &memoryRead{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+func (storage *storageMemory) Saver(logger Logger) StorageSaver {
+	return &memorySave{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Memory storage is always available
+func (storage *storageMemory) Ping() error {
+	storage.health.Set(health_storage, true, "in-memory storage, not persisted")
+	return nil
+}
+
+func (storage *storageMemory) Close() {}
+
+// Copy of stored source, source and title history are not shared
+func (storage *storageMemory) sourceCopy(m *storedSource) storedSource {
+	result := *m
+	result.Source = protobuf_proto.Clone(m.Source).(*proto.FLO_SOURCE)
+	result.TitleHistory = append([]storedTitle{}, m.TitleHistory...)
+	return result
+}
+
+// Copy of stored message, message and revisions are not shared
+func (storage *storageMemory) messageCopy(m *storedMessage) storedMessage {
+	result := *m
+	result.Message = protobuf_proto.Clone(m.Message).(*proto.FLO_MESSAGE)
+	result.Revisions = append([]storedRevision{}, m.Revisions...)
+	return result
+}
+
+type memoryRead struct {
+	storage *storageMemory
+	logger  Logger
+}
+
+func (op *memoryRead) Sources(ctx context.Context, query sourcesQuery) ([]storedSource, error) {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	uids := map[string]bool{}
+	for _, uid := range query.Uids {
+		uids[uid] = true
+	}
+
+	result := []storedSource{}
+
+	for _, m := range storage.sources {
+		if len(uids) > 0 && !uids[m.ID] {
+			continue
+		}
+		if query.AfterID != "" && m.ID <= query.AfterID {
+			continue
+		}
+		if query.MonitoredOnly && !m.Monitored {
+			continue
+		}
+		if !matchFilterFlags(m.Source.Flags, query.FilterFlags) {
+			continue
+		}
+
+		result = append(result, storage.sourceCopy(m))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	if query.Limit > 0 && int64(len(result)) > query.Limit {
+		result = result[:query.Limit]
+	}
+
+	for i := range result {
+		storedSourceResult(&result[i], query.IncludeTitleHistory)
+	}
+
+	return result, nil
+}
+
+func (op *memoryRead) SourceMonitored(ctx context.Context, uid string) (bool, error) {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	m, ok := storage.sources[uid]
+
+	return ok && m.Monitored, nil
+}
+
+func (op *memoryRead) Backfill(ctx context.Context, sourceUid string) (*storedBackfill, error) {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	m, ok := storage.backfill[sourceUid]
+	if !ok {
+		return nil, nil
+	}
+
+	result := *m
+	return &result, nil
+}
+
+func (op *memoryRead) Messages(ctx context.Context, query messagesQuery) ([]storedMessage, error) {
+	since := primitive.NewDateTimeFromTime(query.Since)
+	before := primitive.NewDateTimeFromTime(query.Before)
+
+	var after primitive.DateTime
+	if query.After != nil {
+		after = primitive.NewDateTimeFromTime(query.After.CreatedAt)
+	}
+
+	match := func(m *storedMessage) bool {
+		if !query.Since.IsZero() && m.MessageCreatedAt < since {
+			return false
+		}
+		if !query.Before.IsZero() && m.MessageCreatedAt >= before {
+			return false
+		}
+
+		if !matchFilterFlags(m.Message.Flags, query.FilterFlags) {
+			return false
+		}
+
+		switch {
+		case query.TopicID == 0:
+		case query.TopicID == 1 && query.Forum:
+			// General topic messages of a forum have no topic id
+			if m.Message.TopicId > 1 {
+				return false
+			}
+		default:
+			if m.Message.TopicId != query.TopicID {
+				return false
+			}
+		}
+
+		if query.AuthorUid != "" && m.Message.GetAuthor().GetSourceUid() != query.AuthorUid {
+			return false
+		}
+
+		switch query.Deleted {
+		case proto.FlotgDeletedFilter_DeletedExclude:
+			if m.DeletedAt != 0 {
+				return false
+			}
+		case proto.FlotgDeletedFilter_DeletedOnly:
+			if m.DeletedAt == 0 {
+				return false
+			}
+		}
+
+		// Resume after the cursor position, in sort order: (message_created_at, _id)
+		if query.After != nil {
+			cmp := messageOrder(m.MessageCreatedAt, m.ID, after, query.After.ID)
+			if query.Descending {
+				cmp = -cmp
+			}
+			if cmp <= 0 {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return op.findMessages(query.SourceUid, match, query.Descending, query.Limit, query.IncludeRevisions), nil
+}
+
+func (op *memoryRead) Message(ctx context.Context, sourceUid, messageUid string) (*storedMessage, error) {
+	result := op.findMessages(sourceUid, func(m *storedMessage) bool {
+		return m.ID == messageUid
+	}, false, 1, false)
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return &result[0], nil
+}
+
+func (op *memoryRead) Replies(ctx context.Context, sourceUid string, messageUids []string, limit int64) ([]storedMessage, error) {
+	uids := map[string]bool{}
+	for _, uid := range messageUids {
+		uids[uid] = true
+	}
+
+	return op.findMessages(sourceUid, func(m *storedMessage) bool {
+		return uids[m.Message.ReplyToMessageUid]
+	}, false, limit, false), nil
+}
+
+// Messages of a source matching the filter, sorted by (message_created_at, _id)
+func (op *memoryRead) findMessages(sourceUid string, match func(m *storedMessage) bool, descending bool, limit int64, includeRevisions bool) []storedMessage {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	result := []storedMessage{}
+
+	for _, m := range storage.messages[sourceUid] {
+		if match(m) {
+			result = append(result, storage.messageCopy(m))
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		cmp := messageOrder(result[i].MessageCreatedAt, result[i].ID, result[j].MessageCreatedAt, result[j].ID)
+		if descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+
+	if limit > 0 && int64(len(result)) > limit {
+		result = result[:limit]
+	}
+
+	for i := range result {
+		storedMessageResult(op.logger, &result[i], includeRevisions)
+	}
+
+	return result
+}
+
+// Compare messages by (message_created_at, _id) like storage sort order: -1, 0 or 1
+func messageOrder(createdAt primitive.DateTime, id string, otherCreatedAt primitive.DateTime, otherId string) int {
+	switch {
+	case createdAt < otherCreatedAt:
+		return -1
+	case createdAt > otherCreatedAt:
+		return 1
+	case id < otherId:
+		return -1
+	case id > otherId:
+		return 1
+	}
+	return 0
+}
+
+type memorySave struct {
+	storage *storageMemory
+	logger  Logger
+}
+
+func (op *memorySave) Source(ctx context.Context, c *converter, source *proto.FLO_SOURCE) (StorageObjectID, error) {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	now := primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	stored, ok := storage.sources[source.SourceUid]
+	if !ok {
+		storage.sources[source.SourceUid] = &storedSource{
+			ID:        source.SourceUid,
+			CreatedAt: now,
+			Source:    protobuf_proto.Clone(source).(*proto.FLO_SOURCE),
+			SourceRPC: primitive.Binary{
+				Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+				Data:    c.encodeRpcToBytes(source),
+			},
+			CanonicalTitle: source.Title,
+			TitleSince:     now,
+			Monitored:      source.Monitored, // only for new source, existing source state is kept
+		}
+
+		op.logger.Message(gelf.LOG_INFO, "storage_save", "Source saved (memory)", map[string]any{
+			"id": source.SourceUid,
+		})
+
+		return StorageObjectID(source.SourceUid), nil
+	}
+
+	changed, titleChanged := sourceChanges(stored, source)
+	if !changed {
+		return StorageObjectID(stored.ID), nil
+	}
+
+	if titleChanged {
+		stored.TitleHistory = append(stored.TitleHistory, sourcePreviousTitle(stored, now))
+		stored.TitleSince = now
+	}
+
+	stored.Source = protobuf_proto.Clone(source).(*proto.FLO_SOURCE)
+	stored.SourceRPC = primitive.Binary{
+		Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+		Data:    c.encodeRpcToBytes(source),
+	}
+	stored.CanonicalTitle = source.Title
+	stored.UpdatedAt = now
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", "Source updated (memory)", map[string]any{
+		"id":            stored.ID,
+		"title_changed": titleChanged,
+		"title":         source.Title,
+	})
+
+	return StorageObjectID(stored.ID), nil
+}
+
+func (op *memorySave) Monitoring(ctx context.Context, uid string, monitored bool) error {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	stored, ok := storage.sources[uid]
+	if !ok {
+		return errSourceNotFound
+	}
+
+	stored.Monitored = monitored
+	stored.MonitoredChangedAt = primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", "Source monitoring changed", map[string]any{
+		"id":        uid,
+		"monitored": monitored,
+	})
+
+	return nil
+}
+
+func (op *memorySave) Message(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error) {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	messages, ok := storage.messages[source.SourceUid]
+	if !ok {
+		messages = map[string]*storedMessage{}
+		storage.messages[source.SourceUid] = messages
+	}
+
+	if _, exists := messages[message.MessageUid]; exists {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Message exists already -- skipped (memory)", map[string]any{
+			"id": message.MessageUid,
+		})
+		return StorageObjectID(message.MessageUid), nil
+	}
+
+	messages[message.MessageUid] = &storedMessage{
+		ID:               message.MessageUid,
+		CreatedAt:        primitive.NewDateTimeFromTime(time.Now().UTC()),
+		MessageCreatedAt: primitive.NewDateTimeFromTime(message.CreatedAt.AsTime()),
+		Metadata: storedMetadata{
+			SourceUid: source.SourceUid,
+			Flags:     message.Flags,
+		},
+		TgID:    messageIDFromUid(message.MessageUid),
+		Message: protobuf_proto.Clone(message).(*proto.FLO_MESSAGE),
+		MessageRPC: primitive.Binary{
+			Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+			Data:    c.encodeRpcToBytes(message),
+		},
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", "Message saved (memory)", map[string]any{
+		"id": message.MessageUid,
+	})
+
+	return StorageObjectID(message.MessageUid), nil
+}
+
+// New messages are saved one at a time
+func (op *memorySave) MessageBatched(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE, saved func()) error {
+	if _, err := op.Message(ctx, c, source, message); err != nil {
+		return err
+	}
+	if saved != nil {
+		saved()
+	}
+	return nil
+}
+
+func (op *memorySave) MessageEdit(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error) {
+	storage := op.storage
+
+	storage.mu.Lock()
+
+	stored, ok := storage.messages[source.SourceUid][message.MessageUid]
+	if !ok {
+		storage.mu.Unlock()
+		return op.Message(ctx, c, source, message)
+	}
+
+	defer storage.mu.Unlock()
+
+	editedAt := primitive.NewDateTimeFromTime(message.EditedAt.AsTime())
+
+	if stored.EditedAt >= editedAt {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Message edit is saved already -- skipped", map[string]any{
+			"id": message.MessageUid,
+		})
+		return StorageObjectID(stored.ID), nil
+	}
+
+	stored.Revisions = append(stored.Revisions, storedRevision{
+		EditedAt:   stored.EditedAt,
+		MessageRPC: stored.MessageRPC,
+	})
+	stored.EditedAt = editedAt
+
+	// Deleted flag is kept, message may be edited before deletion is received
+	deleted := stored.Message.Flags & int32(proto.FLAGS_Deleted)
+
+	stored.Message = protobuf_proto.Clone(message).(*proto.FLO_MESSAGE)
+	stored.Message.Flags |= deleted
+	stored.Metadata.Flags = stored.Message.Flags
+	stored.MessageRPC = primitive.Binary{
+		Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+		Data:    c.encodeRpcToBytes(message),
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", "Message edit saved (memory)", map[string]any{
+		"id":        stored.ID,
+		"revisions": len(stored.Revisions),
+	})
+
+	return StorageObjectID(stored.ID), nil
+}
+
+func (op *memorySave) MessagesDeleted(ctx context.Context, sourceUid string, messageIDs []int, deletedAt time.Time) (int64, error) {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	ids := map[int]bool{}
+	for _, id := range messageIDs {
+		ids[id] = true
+	}
+
+	count := int64(0)
+
+	for uid, messages := range storage.messages {
+		if sourceUid != "" && uid != sourceUid {
+			continue
+		}
+
+		for _, stored := range messages {
+			if !ids[stored.TgID] || stored.DeletedAt != 0 {
+				continue
+			}
+			if sourceUid == "" && stored.Metadata.Flags&int32(proto.FLAGS_Channel) != 0 {
+				continue
+			}
+
+			stored.DeletedAt = primitive.NewDateTimeFromTime(deletedAt)
+			stored.Message.Flags |= int32(proto.FLAGS_Deleted)
+			stored.Metadata.Flags = stored.Message.Flags
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (op *memorySave) Backfill(ctx context.Context, state *storedBackfill) error {
+	storage := op.storage
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+
+	state.UpdatedAt = primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	stored := *state
+	storage.backfill[state.ID] = &stored
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	protobuf_proto "github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testStorageStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type testStorage struct {
+	ctx       context.Context
+	converter *converter
+	read      StorageReader
+	save      StorageSaver
+}
+
+func newTestStorage() *testStorage {
+	bootstrap := Bootstrap{
+		Storage: NewStorageMemory(NewHealth()),
+		Logger:  dummyLogging{},
+	}
+
+	return &testStorage{
+		ctx:       context.Background(),
+		converter: newConverter(bootstrap, nil),
+		read:      bootstrap.Storage.Reader(bootstrap.Logger),
+		save:      bootstrap.Storage.Saver(bootstrap.Logger),
+	}
+}
+
+func (s *testStorage) source(t *testing.T, peerID int64, flags proto.FLAGS) *proto.FLO_SOURCE {
+	t.Helper()
+
+	source := &proto.FLO_SOURCE{
+		Flags:     int32(proto.FLAGS_V1|proto.FLAGS_Tg) | int32(flags),
+		SourceUid: makeSourceUid(peerID),
+		Title:     fmt.Sprintf("source %d", peerID),
+		Monitored: true,
+	}
+
+	if _, err := s.save.Source(s.ctx, s.converter, source); err != nil {
+		t.Fatal(err)
+	}
+
+	return source
+}
+
+// Message [id] of source created [minute] minutes after test start, with source flags and [flags]
+func (s *testStorage) message(t *testing.T, source *proto.FLO_SOURCE, id int, minute int, flags proto.FLAGS) *proto.FLO_MESSAGE {
+	t.Helper()
+
+	message := &proto.FLO_MESSAGE{
+		Flags:      source.Flags | int32(flags),
+		SourceUid:  source.SourceUid,
+		MessageUid: makeMessageUid(source.SourceUid, id),
+		CreatedAt:  timestamppb.New(testStorageStart.Add(time.Duration(minute) * time.Minute)),
+		Text:       fmt.Sprintf("message %d", id),
+	}
+
+	if _, err := s.save.Message(s.ctx, s.converter, source, message); err != nil {
+		t.Fatal(err)
+	}
+
+	return message
+}
+
+func (s *testStorage) messages(t *testing.T, query messagesQuery) []storedMessage {
+	t.Helper()
+
+	result, err := s.read.Messages(s.ctx, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
+
+func storedMessageUids(messages []storedMessage) []string {
+	uids := []string{}
+	for _, m := range messages {
+		uids = append(uids, m.ID)
+	}
+	return uids
+}
+
+func testMessageUids(sourceUid string, ids ...int) []string {
+	uids := []string{}
+	for _, id := range ids {
+		uids = append(uids, makeMessageUid(sourceUid, id))
+	}
+	return uids
+}
+
+func checkUids(t *testing.T, name string, got, expected []string) {
+	t.Helper()
+
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("%s: got %v, expected %v", name, got, expected)
+	}
+}
+
+func TestStorageMemoryMessagesPaging(t *testing.T) {
+	s := newTestStorage()
+
+	source := s.source(t, 100, proto.FLAGS_Channel)
+
+	// Messages 3 and 4 are created at the same time, ordered by uid
+	for id, minute := range map[int]int{1: 1, 2: 2, 3: 3, 4: 3, 5: 5, 6: 6, 7: 7} {
+		s.message(t, source, id, minute, 0)
+	}
+
+	other := s.source(t, 200, proto.FLAGS_Channel)
+	s.message(t, other, 1, 1, 0)
+
+	page := func(descending bool) []string {
+		query := messagesQuery{
+			SourceUid:  source.SourceUid,
+			Limit:      3,
+			Descending: descending,
+		}
+
+		uids := []string{}
+		for pages := 0; pages < 10; pages++ {
+			result := s.messages(t, query)
+			uids = append(uids, storedMessageUids(result)...)
+
+			if int64(len(result)) < query.Limit {
+				return uids
+			}
+
+			last := result[len(result)-1]
+			query.After = &messagesCursor{
+				CreatedAt: last.MessageCreatedAt.Time(),
+				ID:        last.ID,
+			}
+		}
+
+		t.Fatal("paging did not complete")
+		return nil
+	}
+
+	checkUids(t, "ascending pages", page(false), testMessageUids(source.SourceUid, 1, 2, 3, 4, 5, 6, 7))
+	checkUids(t, "descending pages", page(true), testMessageUids(source.SourceUid, 7, 6, 5, 4, 3, 2, 1))
+
+	checkUids(t, "since and before", storedMessageUids(s.messages(t, messagesQuery{
+		SourceUid: source.SourceUid,
+		Since:     testStorageStart.Add(3 * time.Minute),
+		Before:    testStorageStart.Add(6 * time.Minute),
+	})), testMessageUids(source.SourceUid, 3, 4, 5))
+}
+
+func TestStorageMemoryFilterFlags(t *testing.T) {
+	s := newTestStorage()
+
+	channel := s.source(t, 100, proto.FLAGS_Channel)
+	s.source(t, 200, proto.FLAGS_Group|proto.FLAGS_Forum)
+	s.source(t, 300, proto.FLAGS_User|proto.FLAGS_Bot)
+
+	s.message(t, channel, 1, 1, 0)
+	s.message(t, channel, 2, 2, proto.FLAGS_Service)
+	s.message(t, channel, 3, 3, proto.FLAGS_ForwardFromSource)
+	s.message(t, channel, 4, 4, proto.FLAGS_Service|proto.FLAGS_ForwardFromSource)
+
+	messageTests := []struct {
+		name        string
+		filterFlags []int32
+		expected    []int
+	}{
+		{"no filter", nil, []int{1, 2, 3, 4}},
+		{"one mask", []int32{int32(proto.FLAGS_Service)}, []int{2, 4}},
+		{"any of masks", []int32{int32(proto.FLAGS_Service), int32(proto.FLAGS_ForwardFromSource)}, []int{2, 3, 4}},
+		{"all bits of mask", []int32{int32(proto.FLAGS_Service | proto.FLAGS_ForwardFromSource)}, []int{4}},
+		{"no match", []int32{int32(proto.FLAGS_Deleted)}, []int{}},
+	}
+
+	for _, tt := range messageTests {
+		checkUids(t, "messages, "+tt.name, storedMessageUids(s.messages(t, messagesQuery{
+			SourceUid:   channel.SourceUid,
+			FilterFlags: tt.filterFlags,
+		})), testMessageUids(channel.SourceUid, tt.expected...))
+	}
+
+	sourceTests := []struct {
+		name        string
+		filterFlags []int32
+		expected    []int64
+	}{
+		{"no filter", nil, []int64{100, 200, 300}},
+		{"channels", []int32{int32(proto.FLAGS_Channel)}, []int64{100}},
+		{"groups and users", []int32{int32(proto.FLAGS_Group), int32(proto.FLAGS_User)}, []int64{200, 300}},
+		{"forum groups", []int32{int32(proto.FLAGS_Group | proto.FLAGS_Forum)}, []int64{200}},
+		{"bot channels", []int32{int32(proto.FLAGS_Channel | proto.FLAGS_Bot)}, []int64{}},
+	}
+
+	for _, tt := range sourceTests {
+		result, err := s.read.Sources(s.ctx, sourcesQuery{FilterFlags: tt.filterFlags})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := []string{}
+		for _, source := range result {
+			got = append(got, source.ID)
+		}
+
+		expected := []string{}
+		for _, peerID := range tt.expected {
+			expected = append(expected, makeSourceUid(peerID))
+		}
+
+		checkUids(t, "sources, "+tt.name, got, expected)
+	}
+}
+
+func TestStorageMemoryMessageEdit(t *testing.T) {
+	s := newTestStorage()
+
+	source := s.source(t, 100, proto.FLAGS_Group)
+	message := s.message(t, source, 1, 1, 0)
+
+	edit := func(text string, minute int) {
+		t.Helper()
+
+		edited := protobuf_proto.Clone(message).(*proto.FLO_MESSAGE)
+		edited.Text = text
+		edited.EditedAt = timestamppb.New(testStorageStart.Add(time.Duration(minute) * time.Minute))
+
+		if _, err := s.save.MessageEdit(s.ctx, s.converter, source, edited); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	edit("first edit", 10)
+	edit("second edit", 20)
+	// Edit received again (or older one) is skipped
+	edit("first edit", 10)
+
+	// Deleted flag is kept when message is edited after deletion is saved
+	if _, err := s.save.MessagesDeleted(s.ctx, "", []int{1}, testStorageStart.Add(25*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	edit("third edit", 30)
+
+	result := s.messages(t, messagesQuery{SourceUid: source.SourceUid, IncludeRevisions: true})
+	if len(result) != 1 {
+		t.Fatalf("got %d messages, expected 1", len(result))
+	}
+
+	got := result[0].Message
+	if got.Text != "third edit" {
+		t.Errorf("text is %q, expected last edit", got.Text)
+	}
+	if got.Flags&int32(proto.FLAGS_Deleted) == 0 || got.DeletedAt == nil {
+		t.Error("deleted message is not deleted after edit")
+	}
+
+	texts := []string{}
+	for _, r := range got.Revisions {
+		texts = append(texts, r.Text)
+	}
+	checkUids(t, "revisions", texts, []string{"message 1", "first edit", "second edit", "third edit"})
+
+	if r := got.Revisions[0]; r.EditedAt != nil {
+		t.Errorf("original revision has edit time %v", r.EditedAt.AsTime())
+	}
+	if r := got.Revisions[2]; !r.EditedAt.AsTime().Equal(testStorageStart.Add(20 * time.Minute)) {
+		t.Errorf("revision edit time is %v", r.EditedAt.AsTime())
+	}
+
+	result = s.messages(t, messagesQuery{SourceUid: source.SourceUid})
+	if n := len(result[0].Message.Revisions); n != 0 {
+		t.Errorf("got %d revisions, expected none without IncludeRevisions", n)
+	}
+}
+
+func TestStorageMemoryMessagesDeleted(t *testing.T) {
+	s := newTestStorage()
+
+	user := s.source(t, 100, proto.FLAGS_User)
+	group := s.source(t, 200, proto.FLAGS_Group)
+	channel := s.source(t, 300, proto.FLAGS_Channel)
+
+	for _, source := range []*proto.FLO_SOURCE{user, group, channel} {
+		s.message(t, source, 1, 1, 0)
+		s.message(t, source, 2, 2, 0)
+	}
+
+	deletedAt := testStorageStart.Add(time.Hour)
+
+	deleted := func(sourceUid string, messageIDs []int, expected int64) {
+		t.Helper()
+
+		n, err := s.save.MessagesDeleted(s.ctx, sourceUid, messageIDs, deletedAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != expected {
+			t.Errorf("deleted %d messages of %q, expected %d", n, sourceUid, expected)
+		}
+	}
+
+	// Without source, telegram message ids are of users and groups (ids of channel messages are per channel)
+	deleted("", []int{2, 5}, 2)
+	deleted(channel.SourceUid, []int{1}, 1)
+	// Deleted again is not counted
+	deleted("", []int{2}, 0)
+
+	deletedTests := []struct {
+		source   *proto.FLO_SOURCE
+		filter   proto.FlotgDeletedFilter
+		expected []int
+	}{
+		{user, proto.FlotgDeletedFilter_DeletedInclude, []int{1, 2}},
+		{user, proto.FlotgDeletedFilter_DeletedExclude, []int{1}},
+		{user, proto.FlotgDeletedFilter_DeletedOnly, []int{2}},
+		{group, proto.FlotgDeletedFilter_DeletedOnly, []int{2}},
+		{channel, proto.FlotgDeletedFilter_DeletedOnly, []int{1}},
+		{channel, proto.FlotgDeletedFilter_DeletedExclude, []int{2}},
+	}
+
+	for _, tt := range deletedTests {
+		checkUids(t, fmt.Sprintf("%s %s", tt.source.Title, tt.filter), storedMessageUids(s.messages(t, messagesQuery{
+			SourceUid: tt.source.SourceUid,
+			Deleted:   tt.filter,
+		})), testMessageUids(tt.source.SourceUid, tt.expected...))
+	}
+
+	for _, m := range s.messages(t, messagesQuery{SourceUid: user.SourceUid, Deleted: proto.FlotgDeletedFilter_DeletedOnly}) {
+		if m.Message.Flags&int32(proto.FLAGS_Deleted) == 0 {
+			t.Errorf("%s has no Deleted flag", m.ID)
+		}
+		if m.Message.DeletedAt == nil || !m.Message.DeletedAt.AsTime().Equal(deletedAt) {
+			t.Errorf("%s deleted at %v, expected %v", m.ID, m.Message.DeletedAt, deletedAt)
+		}
+	}
+}
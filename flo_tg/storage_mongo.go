@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+const (
+	db_collection_sources  = "tgv1-sources"
+	db_collection_backfill = "tgv1-backfill"
+	db_collection_messages = "tgv1-messages"
+
+	// Messages of all sources in one timeseries collection, by source uid in metadata (default without per-source collections)
+	mongo_messages_single = "single"
+	// Messages in a timeseries collection per source, named by source uid (before migrate-messages)
+	mongo_messages_per_source = "per_source"
+)
+
+// Storage in MongoDB database: sources and backfill collections, and timeseries collection of messages
+type storageMongo struct {
+	logger   Logger
+	health   *Health
+	mgClient *mongo.Client
+	dbName   string
+	messages string // layout of messages collections
+
+	collections *collectionRegistry
+
+	// New messages are inserted in batches when not nil, see EnableBatching
+	batcher *messageBatcher
+}
+
+func NewStorageMongo(uri string, databaseName string, messagesLayout string, logger Logger, health *Health) *storageMongo {
+
+	serverAPI := options.ServerAPI(options.ServerAPIVersion1)
+	opts := options.Client().ApplyURI(uri).SetServerAPIOptions(serverAPI)
+
+	client, err := mongo.Connect(context.TODO(), opts)
+	if err != nil {
+		panic(err)
+	}
+
+	return &storageMongo{
+		logger:   logger,
+		health:   health,
+		mgClient: client,
+		dbName:   databaseName,
+		messages: messagesLayout,
+
+		collections: &collectionRegistry{},
+	}
+}
+
+// Fill known collections registry, so first saves do not list collections
+func (storage *storageMongo) WarmCollections(ctx context.Context) error {
+	start := time.Now()
+
+	n, err := storage.collections.Warm(ctx, storage.mgClient.Database(storage.dbName))
+	if err != nil {
+		storage.logger.Message(gelf.LOG_WARNING, "storage", "Known collections registry warm up failed", map[string]any{
+			"err": err,
+		})
+		return err
+	}
+
+	storage.logger.Message(gelf.LOG_INFO, "storage", "Known collections registry is warm", map[string]any{
+		"collections": n,
+		"latency_ms":  latencyMs(start),
+	})
+
+	return nil
+}
+
+// Insert new messages in batches of up to [maxSize] messages per collection, flushed at most [maxDelay] after saved.
+// Timed flush is run with the [queue].
+func (storage *storageMongo) EnableBatching(queue *Queue, maxSize int, maxDelay time.Duration) {
+	storage.batcher = newMessageBatcher(storage, queue, maxSize, maxDelay)
+
+	storage.logger.Message(gelf.LOG_INFO, "storage", "Messages are inserted in batches", map[string]any{
+		"batch_size":     maxSize,
+		"batch_delay_ms": maxDelay.Milliseconds(),
+	})
+}
+
+// Insert pending messages of the collection before it is read or updated
+func (storage *storageMongo) flushMessages(ctx context.Context, logger Logger, colName string) error {
+	if storage.batcher == nil {
+		return nil
+	}
+	return storage.batcher.Flush(ctx, logger, colName)
+}
+
+// Milliseconds since start, as reported in logs
+func latencyMs(start time.Time) float64 {
+	return float64(time.Since(start).Microseconds()) / 1000
+}
+
+// Collection with messages of a source, and filter selecting messages of the source in it
+func (storage *storageMongo) messagesCollection(sourceUid string) (string, bson.D) {
+	if storage.messages == mongo_messages_per_source {
+		return strings.Trim(sourceUid, "- "), bson.D{}
+	}
+	return db_collection_messages, bson.D{{Key: "metadata.source_uid", Value: sourceUid}}
+}
+
+// Field of message flags in filters: flags in metadata (meta field of timeseries buckets) of the messages collection.
+// Per-source collections may have messages saved before flags were kept in metadata.
+func (storage *storageMongo) messagesFlagsField() string {
+	if storage.messages == mongo_messages_per_source {
+		return "message.flags"
+	}
+	return "metadata.flags"
+}
+
+// Collections with messages of the sources, and filter selecting messages of the sources in each
+func (storage *storageMongo) messagesCollections(sourceUids []string) map[string]bson.D {
+	result := map[string]bson.D{}
+
+	if storage.messages == mongo_messages_per_source {
+		for _, sourceUid := range sourceUids {
+			colName, sourceFilter := storage.messagesCollection(sourceUid)
+			result[colName] = sourceFilter
+		}
+		return result
+	}
+
+	result[db_collection_messages] = bson.D{{Key: "metadata.source_uid", Value: bson.D{{Key: "$in", Value: sourceUids}}}}
+
+	return result
+}
+
+func (storage *storageMongo) Reader(logger Logger) StorageReader {
+	return &mongoRead{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+func (storage *storageMongo) Saver(logger Logger) StorageSaver {
+	return &mongoSave{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Ping database, result is reported as storage health
+func (storage *storageMongo) Ping() error {
+	result := &bson.M{}
+	err := storage.mgClient.Database(storage.dbName).RunCommand(context.TODO(), bson.D{{Key: "ping", Value: 1}}).Decode(&result)
+
+	if err != nil {
+		storage.health.Set(health_storage, false, "mongodb ping failed: "+err.Error())
+	} else {
+		storage.health.Set(health_storage, true, "mongodb ping ok")
+	}
+
+	return err
+}
+
+func (storage *storageMongo) Close() {
+	// Pending messages are inserted before disconnect
+	if storage.batcher != nil {
+		storage.batcher.Close(context.TODO(), storage.logger)
+	}
+
+	if err := storage.mgClient.Disconnect(context.TODO()); err != nil {
+		storage.logger.Message(gelf.LOG_WARNING, "storage", "ERROR Close() mongodb connection", map[string]any{
+			"err": err,
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+const (
+	mongo_batch_size_default  = 100
+	mongo_batch_delay_default = 500 * time.Millisecond
+)
+
+// New messages waiting to be inserted in one collection, in order they were saved
+type pendingMessages struct {
+	docs  []storedMessage
+	saved []func()
+}
+
+// Groups new messages per collection and inserts them with InsertMany, when [maxSize] messages are pending
+// or [maxDelay] after the first pending message. Timed flush is run with the Queue, as other storage operations.
+// Messages of a collection are flushed before they are read, edited or marked deleted, so reads and updates
+// see messages in order they were saved. Saved callbacks are called in the same order.
+// Messages failed to insert with the whole batch are kept pending, and inserted with the next flush.
+type messageBatcher struct {
+	storage  *storageMongo
+	queue    *Queue
+	maxSize  int
+	maxDelay time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*pendingMessages // by collection name
+	timer   *time.Timer                 // timed flush of all collections, nil when not scheduled
+	closed  bool
+}
+
+func newMessageBatcher(storage *storageMongo, queue *Queue, maxSize int, maxDelay time.Duration) *messageBatcher {
+	return &messageBatcher{
+		storage:  storage,
+		queue:    queue,
+		maxSize:  maxSize,
+		maxDelay: maxDelay,
+		pending:  map[string]*pendingMessages{},
+	}
+}
+
+// Add message to pending messages of the collection, [saved] is called once it is inserted or found stored already.
+// Collection is flushed when the batch is full, message is kept pending if flush fails.
+func (b *messageBatcher) Add(ctx context.Context, logger Logger, colName string, m storedMessage, saved func()) error {
+	b.mu.Lock()
+
+	p := b.pending[colName]
+	if p == nil {
+		p = &pendingMessages{}
+		b.pending[colName] = p
+	}
+
+	p.docs = append(p.docs, m)
+	p.saved = append(p.saved, saved)
+
+	full := len(p.docs) >= b.maxSize
+
+	b.schedule()
+
+	b.mu.Unlock()
+
+	if full {
+		return b.Flush(ctx, logger, colName)
+	}
+
+	return nil
+}
+
+// Start flush timer when messages are pending. Must be called with mu locked.
+func (b *messageBatcher) schedule() {
+	if b.closed || b.timer != nil || len(b.pending) == 0 {
+		return
+	}
+
+	b.timer = time.AfterFunc(b.maxDelay, b.flushLater)
+}
+
+// Timer func: flush all collections with the Queue
+func (b *messageBatcher) flushLater() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.timer = nil
+
+	if b.closed {
+		return
+	}
+
+	op := func(ctx context.Context) {
+		if err := b.FlushAll(ctx, b.storage.logger); err != nil {
+			b.storage.logger.Message(gelf.LOG_ERR, "storage_save", "Timed flush failed (Messages batch)", map[string]any{
+				"err": err,
+			})
+		}
+	}
+
+	// Queue is full or stopped: flush is tried again later, pending messages are flushed by storage Close() on shutdown
+	if !b.queue.TryEnqueue(op) {
+		b.schedule()
+	}
+}
+
+// Stop flush timer and insert pending messages of every collection, no messages are added after
+func (b *messageBatcher) Close(ctx context.Context, logger Logger) error {
+	b.mu.Lock()
+	b.closed = true
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	b.mu.Unlock()
+
+	err := b.FlushAll(ctx, logger)
+
+	b.mu.Lock()
+	lost := 0
+	for _, p := range b.pending {
+		lost += len(p.docs)
+	}
+	b.mu.Unlock()
+
+	if lost > 0 {
+		logger.Message(gelf.LOG_CRIT, "storage_save", "Messages lost! Pending messages are not inserted on close (Messages batch)", map[string]any{
+			"lost": lost,
+			"err":  err,
+		})
+	}
+
+	return err
+}
+
+// Insert pending messages of every collection
+func (b *messageBatcher) FlushAll(ctx context.Context, logger Logger) error {
+	b.mu.Lock()
+	names := make([]string, 0, len(b.pending))
+	for colName := range b.pending {
+		names = append(names, colName)
+	}
+	b.mu.Unlock()
+
+	var result error
+
+	for _, colName := range names {
+		if err := b.Flush(ctx, logger, colName); err != nil {
+			result = err
+		}
+	}
+
+	return result
+}
+
+// Insert pending messages of the collection.
+// Messages stored already are skipped, as they are with single message save.
+// When the whole batch fails, messages are kept pending before messages added since.
+func (b *messageBatcher) Flush(ctx context.Context, logger Logger, colName string) error {
+	b.mu.Lock()
+	p := b.pending[colName]
+	delete(b.pending, colName)
+	b.mu.Unlock()
+
+	if p == nil || len(p.docs) == 0 {
+		return nil
+	}
+
+	lost, err := b.storage.insertMessages(ctx, logger, colName, p.docs)
+	if err != nil {
+		b.mu.Lock()
+		if added := b.pending[colName]; added != nil {
+			p.docs = append(p.docs, added.docs...)
+			p.saved = append(p.saved, added.saved...)
+		}
+		b.pending[colName] = p
+		b.schedule()
+		b.mu.Unlock()
+
+		return err
+	}
+
+	for i, m := range p.docs {
+		if !lost[messageKey{m.Metadata.SourceUid, m.ID}] && p.saved[i] != nil {
+			p.saved[i]()
+		}
+	}
+
+	if len(lost) > 0 {
+		return errors.Errorf("InsertMany failed for %d messages (Messages batch)", len(lost))
+	}
+
+	return nil
+}
+
+// Stored message of a source
+type messageKey struct{ sourceUid, id string }
+
+// Insert messages of the collection with InsertMany (unordered), messages stored already are skipped.
+// Returns messages failed to insert with an error of their own, they are not inserted on retry.
+// Returns error when messages are not inserted as a whole, insert can be retried.
+func (storage *storageMongo) insertMessages(ctx context.Context, logger Logger, colName string, messages []storedMessage) (map[messageKey]bool, error) {
+	start := time.Now()
+
+	col := storage.mgClient.Database(storage.dbName).Collection(colName)
+
+	logInfo := map[string]any{
+		"col_name": colName,
+		"pending":  len(messages),
+	}
+
+	// Same message saved twice (e.g. by backfill and by update) is inserted once
+	seen := map[messageKey]bool{}
+	unique := []storedMessage{}
+	for _, m := range messages {
+		k := messageKey{m.Metadata.SourceUid, m.ID}
+		if !seen[k] {
+			seen[k] = true
+			unique = append(unique, m)
+		}
+	}
+
+	// Timeseries collections have no unique index on _id, so duplicates are checked before insert.
+	// Time field is in filter, so query is limited to buckets of the messages time.
+	or := make(bson.A, 0, len(unique))
+	for _, m := range unique {
+		_, sourceFilter := storage.messagesCollection(m.Metadata.SourceUid)
+		or = append(or, append(sourceFilter,
+			bson.E{Key: "message_created_at", Value: m.MessageCreatedAt},
+			bson.E{Key: "_id", Value: m.ID},
+		))
+	}
+
+	cur, err := col.Find(ctx, bson.D{{Key: "$or", Value: or}}, options.Find().SetProjection(bson.D{
+		{Key: "_id", Value: 1},
+		{Key: "metadata.source_uid", Value: 1},
+	}))
+	if err != nil {
+		logger.Message(gelf.LOG_ALERT, "storage_save", "Find failed, messages are kept pending (Messages batch)", logInfo, map[string]any{
+			"err": err,
+		})
+		return nil, errors.Wrap(err, "Find failed (Messages batch)")
+	}
+
+	stored := []storedMessage{}
+	if err := cur.All(ctx, &stored); err != nil {
+		logger.Message(gelf.LOG_ALERT, "storage_save", "Find cursor failed, messages are kept pending (Messages batch)", logInfo, map[string]any{
+			"err": err,
+		})
+		return nil, errors.Wrap(err, "Find cursor failed (Messages batch)")
+	}
+
+	exists := map[messageKey]bool{}
+	for _, m := range stored {
+		// Per-source collections have no source uid in metadata
+		if m.Metadata.SourceUid == "" {
+			m.Metadata.SourceUid = unique[0].Metadata.SourceUid
+		}
+		exists[messageKey{m.Metadata.SourceUid, m.ID}] = true
+	}
+
+	docs := []any{}
+	for _, m := range unique {
+		if !exists[messageKey{m.Metadata.SourceUid, m.ID}] {
+			docs = append(docs, m)
+		}
+	}
+
+	lost := map[messageKey]bool{}
+
+	inserted := len(docs)
+	skipped := len(messages) - len(docs)
+
+	if len(docs) > 0 {
+		_, err := col.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+
+		var bulkErr mongo.BulkWriteException
+		if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+			// Unordered insert continues after failed documents, errors are reported per document
+			for _, we := range bulkErr.WriteErrors {
+				m := docs[we.Index].(storedMessage)
+
+				inserted--
+
+				if we.HasErrorCode(11000) { // DuplicateKey
+					logger.Message(gelf.LOG_WARNING, "storage_save", "Duplicate key error -- skipped (Messages batch)", logInfo, map[string]any{
+						"err": we.Error(),
+						"id":  m.ID,
+					})
+					skipped++
+					continue
+				}
+
+				logger.Message(gelf.LOG_CRIT, "storage_save", "Message lost! InsertMany failed for message (Messages batch)", logInfo, map[string]any{
+					"err":        we.Error(),
+					"id":         m.ID,
+					"source_uid": m.Metadata.SourceUid,
+				})
+				lost[messageKey{m.Metadata.SourceUid, m.ID}] = true
+			}
+		} else if err != nil {
+			// Messages inserted before the failure are skipped on retry
+			logger.Message(gelf.LOG_ALERT, "storage_save", "InsertMany failed, messages are kept pending (Messages batch)", logInfo, map[string]any{
+				"err": err,
+			})
+			return nil, errors.Wrap(err, "InsertMany failed (Messages batch)")
+		}
+	}
+
+	logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("InsertMany OK for %d Messages", inserted), logInfo, map[string]any{
+		"skipped":    skipped,
+		"lost":       len(lost),
+		"latency_ms": latencyMs(start),
+	})
+
+	return lost, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-faster/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Registry of collections known to exist in the database, so saves do not list collections every time.
+// Registry is filled from the database when cold: at startup, and after it is invalidated by a collection creation error.
+// Collections dropped by another process are not noticed until registry is invalidated.
+type collectionRegistry struct {
+	mu    sync.Mutex
+	names map[string]bool // nil when cold
+}
+
+// Fill registry with collections of the database, returns number of collections
+func (r *collectionRegistry) Warm(ctx context.Context, db *mongo.Database) (int, error) {
+	names, err := db.ListCollectionNames(ctx, bson.D{})
+	if err != nil {
+		return 0, errors.Wrap(err, "ListCollectionNames failed (collections registry)")
+	}
+
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+
+	r.mu.Lock()
+	r.names = known
+	r.mu.Unlock()
+
+	return len(names), nil
+}
+
+// Tells if collection exists, registry is warmed first when it is cold
+func (r *collectionRegistry) Exists(ctx context.Context, db *mongo.Database, name string) (bool, error) {
+	r.mu.Lock()
+	cold := r.names == nil
+	r.mu.Unlock()
+
+	if cold {
+		if _, err := r.Warm(ctx, db); err != nil {
+			return false, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.names[name], nil
+}
+
+// Add created collection
+func (r *collectionRegistry) Add(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.names != nil {
+		r.names[name] = true
+	}
+}
+
+// Forget known collections, registry is filled from the database on next check
+func (r *collectionRegistry) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.names = nil
+}
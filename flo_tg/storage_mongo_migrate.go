@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+const (
+	// flo_tg migrate-messages: move messages of per-source collections to the messages collection, and exit
+	command_migrate_messages = "migrate-messages"
+
+	mongo_per_source_prefix  = "tgv1-fromid-" // per-source collections are named by source uid, see makeSourceUid
+	mongo_migrate_batch_size = 1000
+)
+
+// Run migrate-messages command with storage configured in environment (MONGO_URI)
+func MigrateMessagesFromEnvironment(ctx context.Context) error {
+	logger := LoggerFromEnvironment()
+	defer logger.Close()
+
+	storage := NewStorageMongo(GetenvStr("MONGO_URI", "mongodb://localhost:27017", true), Mongo_Database, mongo_messages_single, logger, NewHealth())
+	defer storage.Close()
+
+	if err := storage.Ping(); err != nil {
+		return errors.Wrap(err, "ping mongodb failed")
+	}
+
+	return storage.MigrateMessages(ctx, logger)
+}
+
+// Timeseries collections with messages of a source, used before messages of all sources were kept in one collection
+func (storage *storageMongo) perSourceCollections(ctx context.Context) ([]string, error) {
+	db := storage.mgClient.Database(storage.dbName)
+
+	names, err := db.ListCollectionNames(ctx, bson.D{{Key: "type", Value: "timeseries"}})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListCollectionNames failed (per-source messages)")
+	}
+
+	result := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(name, mongo_per_source_prefix) {
+			result = append(result, name)
+		}
+	}
+
+	return result, nil
+}
+
+// Set messages layout: [layout] when set, otherwise per_source when per-source collections exist (not migrated yet), single otherwise.
+// Messages left in per-source collections are not read with the single messages collection, so storage fails to start with them
+// when single layout is set, or when migration was started and not completed.
+func (storage *storageMongo) ChooseMessagesLayout(ctx context.Context, layout string) error {
+	names, err := storage.perSourceCollections(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(names) == 0 {
+		if layout == "" {
+			layout = mongo_messages_single
+		}
+		storage.messages = layout
+		return nil
+	}
+
+	migrating, err := storage.collections.Exists(ctx, storage.mgClient.Database(storage.dbName), db_collection_messages)
+	if err != nil {
+		return errors.Wrap(err, "Known collections check failed (messages layout)")
+	}
+
+	switch {
+	case layout == mongo_messages_single:
+		return errors.Errorf("messages in %d per-source collections are not read with FLOTG_MONGO_MESSAGES=%s, run: flo_tg %s (or set FLOTG_MONGO_MESSAGES=%s)",
+			len(names), mongo_messages_single, command_migrate_messages, mongo_messages_per_source)
+	case layout == "" && migrating:
+		return errors.Errorf("messages are in %d per-source collections and in %s collection, complete migration with: flo_tg %s",
+			len(names), db_collection_messages, command_migrate_messages)
+	case layout == "":
+		storage.logger.Message(gelf.LOG_WARNING, "storage", "Messages are read from per-source collections, to keep them in one collection run: flo_tg "+command_migrate_messages, map[string]any{
+			"collections": len(names),
+		})
+	}
+
+	storage.messages = mongo_messages_per_source
+
+	return nil
+}
+
+// Move messages of every per-source collection to the messages collection, with source uid and flags in metadata.
+// Migration can be interrupted and run again: messages moved already are skipped,
+// and a per-source collection is dropped only when all its messages are in the messages collection.
+func (storage *storageMongo) MigrateMessages(ctx context.Context, logger Logger) error {
+	names, err := storage.perSourceCollections(ctx)
+	if err != nil {
+		return err
+	}
+
+	save := &mongoSave{
+		storage: storage,
+		logger:  logger,
+	}
+
+	if err := save.MakeTimeSeries(ctx, db_collection_messages, "message_created_at"); err != nil {
+		return errors.Wrapf(err, "MakeTimeSeries failed for %s", db_collection_messages)
+	}
+
+	logger.Message(gelf.LOG_INFO, "storage_migrate", "Migrating per-source messages collections", map[string]any{
+		"collections": len(names),
+	})
+
+	for _, name := range names {
+		if err := storage.migrateCollection(ctx, logger, name); err != nil {
+			return errors.Wrapf(err, "migrate %s", name)
+		}
+	}
+
+	logger.Message(gelf.LOG_INFO, "storage_migrate", "Messages migration completed", map[string]any{
+		"collections": len(names),
+	})
+
+	return nil
+}
+
+func (storage *storageMongo) migrateCollection(ctx context.Context, logger Logger, sourceUid string) error {
+	db := storage.mgClient.Database(storage.dbName)
+
+	from := db.Collection(sourceUid)
+	to := db.Collection(db_collection_messages)
+
+	cur, err := from.Find(ctx, bson.D{}, options.Find().SetBatchSize(mongo_migrate_batch_size))
+	if err != nil {
+		return errors.Wrap(err, "Find failed")
+	}
+
+	defer cur.Close(ctx)
+
+	moved := 0
+	batch := []storedMessage{}
+
+	flush := func() error {
+		n, err := storage.migrateBatch(ctx, to, sourceUid, batch)
+		moved += n
+		batch = batch[:0]
+		return err
+	}
+
+	for cur.Next(ctx) {
+		var m storedMessage
+		if err := cur.Decode(&m); err != nil {
+			return errors.Wrapf(err, "Decode failed for %v", cur.ID())
+		}
+
+		m.Metadata = storedMetadata{
+			SourceUid: sourceUid,
+		}
+		m.TgID = messageIDFromUid(m.ID)
+		if m.Message != nil {
+			m.Metadata.Flags = m.Message.Flags
+		}
+
+		batch = append(batch, m)
+
+		if len(batch) >= mongo_migrate_batch_size {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := cur.Err(); err != nil {
+		return errors.Wrap(err, "Find cursor failed")
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	// Collection is dropped only when every message is in the messages collection
+	total, err := from.CountDocuments(ctx, bson.D{})
+	if err != nil {
+		return errors.Wrap(err, "CountDocuments failed (per-source)")
+	}
+
+	migrated, err := to.CountDocuments(ctx, bson.D{{Key: "metadata.source_uid", Value: sourceUid}})
+	if err != nil {
+		return errors.Wrap(err, "CountDocuments failed (messages)")
+	}
+
+	logInfo := map[string]any{
+		"col_name": sourceUid,
+		"moved":    moved,
+		"total":    total,
+		"migrated": migrated,
+	}
+
+	if migrated < total {
+		logger.Message(gelf.LOG_ERR, "storage_migrate", "Per-source collection is not dropped, messages are missing", logInfo)
+		return errors.New("messages are missing in messages collection")
+	}
+
+	if err := from.Drop(ctx); err != nil {
+		return errors.Wrap(err, "Drop failed")
+	}
+
+	logger.Message(gelf.LOG_INFO, "storage_migrate", "Per-source collection migrated and dropped", logInfo)
+
+	return nil
+}
+
+// Insert messages not in messages collection yet, returns number of inserted messages
+func (storage *storageMongo) migrateBatch(ctx context.Context, to *mongo.Collection, sourceUid string, batch []storedMessage) (int, error) {
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(batch))
+	for _, m := range batch {
+		ids = append(ids, m.ID)
+	}
+
+	// Timeseries collections have no unique index on _id, messages moved by interrupted migration are checked
+	existing, err := to.Distinct(ctx, "_id", bson.D{
+		{Key: "metadata.source_uid", Value: sourceUid},
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Distinct failed (messages)")
+	}
+
+	skip := map[string]bool{}
+	for _, id := range existing {
+		if s, ok := id.(string); ok {
+			skip[s] = true
+		}
+	}
+
+	docs := []any{}
+	for _, m := range batch {
+		if !skip[m.ID] {
+			docs = append(docs, m)
+		}
+	}
+
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	if _, err := to.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false)); err != nil {
+		return 0, errors.Wrap(err, "InsertMany failed (messages)")
+	}
+
+	return len(docs), nil
+}
+
+// Sources saved before monitoring state was kept have no monitored field, their messages were saved as of monitored sources.
+// Monitoring is turned on for them, so upgrade does not stop saving messages of every known source.
+func (storage *storageMongo) MigrateMonitored(ctx context.Context) error {
+	col := storage.mgClient.Database(storage.dbName).Collection(db_collection_sources)
+
+	res, err := col.UpdateMany(ctx, bson.D{{Key: "monitored", Value: bson.D{{Key: "$exists", Value: false}}}}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "monitored", Value: true},
+	}}})
+	if err != nil {
+		return errors.Wrap(err, "UpdateMany failed (sources monitored)")
+	}
+
+	if res.ModifiedCount > 0 {
+		storage.logger.Message(gelf.LOG_INFO, "storage", "Monitoring is turned on for sources saved without monitoring state", map[string]any{
+			"sources": res.ModifiedCount,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+type mongoRead struct {
+	storage *storageMongo
+	logger  Logger
+}
+
+// TODO: streaming. use channel, and support context cancellation?
+func (op *mongoRead) Sources(ctx context.Context, query sourcesQuery) ([]storedSource, error) {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	col := db.Collection(db_collection_sources)
+
+	id := bson.D{}
+
+	if len(query.Uids) > 0 {
+		id = append(id, bson.E{Key: "$in", Value: query.Uids})
+	}
+
+	if query.AfterID != "" {
+		id = append(id, bson.E{Key: "$gt", Value: query.AfterID})
+	}
+
+	and := bson.A{}
+
+	if len(id) > 0 {
+		and = append(and, bson.D{{Key: "_id", Value: id}})
+	}
+
+	if query.MonitoredOnly {
+		and = append(and, bson.D{{Key: "monitored", Value: true}})
+	}
+
+	if len(query.FilterFlags) > 0 {
+		and = append(and, filterFlagsMatch("source.flags", query.FilterFlags))
+	}
+
+	filter := filterAnd(and)
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	if query.Limit > 0 {
+		opts.SetLimit(query.Limit)
+	}
+
+	cur, err := col.Find(ctx, filter, opts)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Find documents failed (sources)", map[string]any{
+			"col_name":   db_collection_sources,
+			"debug_uids": strings.Join(query.Uids, ","),
+			"err":        err,
+		})
+		return nil, err
+	}
+
+	result := []storedSource{}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var m storedSource
+		err := cur.Decode(&m)
+
+		if err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Decode failed for DB document (skipped)", map[string]any{
+				"col_name": db_collection_sources,
+				"err":      err,
+				"id":       cur.ID(),
+			})
+		}
+
+		if err := proto.Unmarshal(m.SourceRPC.Data, m.Source); err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Unmarshal RPC failed (skipped)", map[string]any{
+				"col_name": db_collection_sources,
+				"id":       cur.ID(),
+				"err":      err,
+			})
+
+			continue
+		}
+
+		storedSourceResult(&m, query.IncludeTitleHistory)
+
+		result = append(result, m)
+
+	}
+
+	if err := cur.Err(); err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Closing find cursor with error", map[string]any{
+			"col_name": db_collection_sources,
+			"err":      err,
+		})
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Filter matching all conditions, or any document if there are none
+func filterAnd(conditions bson.A) bson.D {
+	if len(conditions) == 0 {
+		return bson.D{}
+	}
+	return bson.D{{Key: "$and", Value: conditions}}
+}
+
+// Filter matching documents with all bits of any filter mask set in field.
+func filterFlagsMatch(field string, filterFlags []int32) bson.D {
+	or := bson.A{}
+	for _, mask := range filterFlags {
+		or = append(or, bson.D{{Key: field, Value: bson.D{{Key: "$bitsAllSet", Value: mask}}}})
+	}
+	return bson.D{{Key: "$or", Value: or}}
+}
+
+func (op *mongoRead) SourceMonitored(ctx context.Context, uid string) (bool, error) {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	col := db.Collection(db_collection_sources)
+
+	m := storedSource{}
+
+	opts := options.FindOne().SetProjection(bson.D{{Key: "monitored", Value: 1}})
+
+	err := col.FindOne(ctx, bson.D{{Key: "_id", Value: uid}}, opts).Decode(&m)
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	} else if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "FindOne failed (source monitored)", map[string]any{
+			"col_name": db_collection_sources,
+			"id":       uid,
+			"err":      err,
+		})
+		return false, err
+	}
+
+	return m.Monitored, nil
+}
+
+func (op *mongoRead) Backfill(ctx context.Context, sourceUid string) (*storedBackfill, error) {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	col := db.Collection(db_collection_backfill)
+
+	m := &storedBackfill{}
+
+	err := col.FindOne(ctx, bson.D{{Key: "_id", Value: sourceUid}}).Decode(m)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	} else if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "FindOne failed (backfill)", map[string]any{
+			"col_name": db_collection_backfill,
+			"id":       sourceUid,
+			"err":      err,
+		})
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// TODO: streaming. use channel, and support context cancellation?
+func (op *mongoRead) Messages(ctx context.Context, query messagesQuery) ([]storedMessage, error) {
+	sourceUid := query.SourceUid
+
+	and := bson.A{}
+
+	createdAt := bson.D{}
+	if !query.Since.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$gte", Value: primitive.NewDateTimeFromTime(query.Since)})
+	}
+	if !query.Before.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$lt", Value: primitive.NewDateTimeFromTime(query.Before)})
+	}
+	if len(createdAt) > 0 {
+		and = append(and, bson.D{{Key: "message_created_at", Value: createdAt}})
+	}
+
+	if len(query.FilterFlags) > 0 {
+		and = append(and, filterFlagsMatch(op.storage.messagesFlagsField(), query.FilterFlags))
+	}
+
+	switch {
+	case query.TopicID == 0:
+	case query.TopicID == 1 && query.Forum:
+		// General topic messages of a forum have no topic id
+		and = append(and, bson.D{{Key: "message.topicid", Value: bson.D{{Key: "$in", Value: bson.A{0, 1, nil}}}}})
+	default:
+		and = append(and, bson.D{{Key: "message.topicid", Value: query.TopicID}})
+	}
+
+	if query.AuthorUid != "" {
+		and = append(and, bson.D{{Key: "message.author.sourceuid", Value: query.AuthorUid}})
+	}
+
+	switch query.Deleted {
+	case pb.FlotgDeletedFilter_DeletedExclude:
+		and = append(and, bson.D{{Key: "deleted_at", Value: bson.D{{Key: "$exists", Value: false}}}})
+	case pb.FlotgDeletedFilter_DeletedOnly:
+		and = append(and, bson.D{{Key: "deleted_at", Value: bson.D{{Key: "$exists", Value: true}}}})
+	}
+
+	sortOrder := 1
+	if query.Descending {
+		sortOrder = -1
+	}
+
+	// Resume after the cursor position, in sort order: (message_created_at, _id)
+	if query.After != nil {
+		next := "$gt"
+		if query.Descending {
+			next = "$lt"
+		}
+
+		after := primitive.NewDateTimeFromTime(query.After.CreatedAt)
+
+		and = append(and, bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "message_created_at", Value: bson.D{{Key: next, Value: after}}}},
+			bson.D{
+				{Key: "message_created_at", Value: after},
+				{Key: "_id", Value: bson.D{{Key: next, Value: query.After.ID}}},
+			},
+		}}})
+	}
+
+	filter := filterAnd(and)
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "message_created_at", Value: sortOrder},
+		{Key: "_id", Value: sortOrder},
+	})
+
+	if query.Limit > 0 {
+		opts.SetLimit(query.Limit)
+	}
+
+	return op.findMessages(ctx, sourceUid, filter, opts, query.IncludeRevisions)
+}
+
+func (op *mongoRead) Message(ctx context.Context, sourceUid, messageUid string) (*storedMessage, error) {
+	result, err := op.findMessages(ctx, sourceUid, bson.D{{Key: "_id", Value: messageUid}}, options.Find().SetLimit(1), false)
+	if err != nil || len(result) == 0 {
+		return nil, err
+	}
+	return &result[0], nil
+}
+
+func (op *mongoRead) Replies(ctx context.Context, sourceUid string, messageUids []string, limit int64) ([]storedMessage, error) {
+	filter := bson.D{{Key: "message.replytomessageuid", Value: bson.D{{Key: "$in", Value: messageUids}}}}
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "message_created_at", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	return op.findMessages(ctx, sourceUid, filter, opts, false)
+}
+
+func (op *mongoRead) findMessages(ctx context.Context, sourceUid string, filter bson.D, opts *options.FindOptions, includeRevisions bool) ([]storedMessage, error) {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	colName, sourceFilter := storage.messagesCollection(sourceUid)
+
+	// Pending new messages are inserted first, so they are read in order with stored ones
+	if err := storage.flushMessages(ctx, op.logger, colName); err != nil {
+		return nil, err
+	}
+
+	col := db.Collection(colName)
+
+	// Messages collection is always queried by source uid in metadata (meta field of timeseries buckets)
+	cur, err := col.Find(ctx, append(sourceFilter, filter...), opts)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Find documents failed (messages by source)", map[string]any{
+			"col_name":   colName,
+			"source_uid": sourceUid,
+			"err":        err,
+		})
+		return nil, err
+	}
+
+	result := []storedMessage{}
+
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var m storedMessage
+		err := cur.Decode(&m)
+
+		if err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Decode failed for DB document (skipped)", map[string]any{
+				"col_name": colName,
+				"err":      err,
+				"id":       cur.ID(),
+			})
+		}
+
+		if err := proto.Unmarshal(m.MessageRPC.Data, m.Message); err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Unmarshal RPC failed (skipped)", map[string]any{
+				"col_name": colName,
+				"id":       cur.ID(),
+				"err":      err,
+			})
+
+			continue
+		}
+
+		storedMessageResult(op.logger, &m, includeRevisions)
+
+		result = append(result, m)
+
+	}
+
+	if err := cur.Err(); err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Closing find cursor with error", map[string]any{
+			"col_name": colName,
+			"err":      err,
+		})
+		return nil, err
+	}
+
+	return result, err
+}
@@ -0,0 +1,544 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/go-faster/errors"
+	protobuf_proto "github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+type mongoSave struct {
+	storage *storageMongo
+	logger  Logger
+}
+
+func (op *mongoSave) Source(ctx context.Context, c *converter, source *proto.FLO_SOURCE) (StorageObjectID, error) {
+	start := time.Now()
+
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	err := op.MakeCollection(ctx, db_collection_sources)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "MakeCollection failed (Sources index)", map[string]any{
+			"col_name": db_collection_sources,
+			"err":      err,
+		})
+		return "", errors.Wrapf(err, "MakeCollection failed for %s", db_collection_sources)
+	}
+
+	col := db.Collection(db_collection_sources)
+
+	stored := storedSource{}
+
+	err = col.FindOne(ctx, bson.D{{Key: "_id", Value: source.SourceUid}}).Decode(&stored)
+	if err == nil {
+		stored.Source = &proto.FLO_SOURCE{}
+		err = protobuf_proto.Unmarshal(stored.SourceRPC.Data, stored.Source)
+	}
+	if err == nil {
+		return op.sourceUpdate(ctx, c, &stored, source, start)
+	} else if err != mongo.ErrNoDocuments {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "FindOne failed (Sources index)", map[string]any{
+			"col_name": db_collection_sources,
+			"err":      err,
+			"id":       source.SourceUid,
+		})
+		return "", errors.Wrap(err, "FindOne failed (Source)")
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	m := storedSource{
+		ID:        source.SourceUid,
+		CreatedAt: now,
+		Source:    source,
+		SourceRPC: primitive.Binary{
+			Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+			Data:    c.encodeRpcToBytes(source),
+		},
+		CanonicalTitle: source.Title,
+		TitleSince:     now,
+		Monitored:      source.Monitored, // only for new source, existing source state is kept
+	}
+
+	res, err := col.InsertOne(ctx, &m)
+	if mongo.IsDuplicateKeyError(err) {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Duplicate key error is OK (Sources index)", map[string]any{
+			"col_name":   db_collection_sources,
+			"err":        err,
+			"id":         m.ID,
+			"latency_ms": latencyMs(start),
+		})
+		return StorageObjectID(m.ID), nil
+	} else if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "InsertOne failed (Sources index)", map[string]any{
+			"col_name":   db_collection_sources,
+			"err":        err,
+			"debug_json": c.encodeToJson(m, true),
+		})
+		return "", errors.Wrap(err, "InsertOne failed (Source)")
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("InsertOne OK for Source %s", res.InsertedID), map[string]any{
+		"col_name":   db_collection_sources,
+		"err":        err,
+		"latency_ms": latencyMs(start),
+	})
+
+	return StorageObjectID(res.InsertedID.(string)), err
+}
+
+func (op *mongoSave) sourceUpdate(ctx context.Context, c *converter, stored *storedSource, source *proto.FLO_SOURCE, start time.Time) (StorageObjectID, error) {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	col := db.Collection(db_collection_sources)
+
+	changed, titleChanged := sourceChanges(stored, source)
+
+	if !changed {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Source is not changed (Sources index)", map[string]any{
+			"col_name":   db_collection_sources,
+			"id":         stored.ID,
+			"latency_ms": latencyMs(start),
+		})
+		return StorageObjectID(stored.ID), nil
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	set := bson.D{
+		{Key: "source", Value: source},
+		{Key: "source_rpc", Value: primitive.Binary{
+			Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+			Data:    c.encodeRpcToBytes(source),
+		}},
+		{Key: "canonical_title", Value: source.Title},
+		{Key: "updated_at", Value: now},
+	}
+
+	update := bson.D{}
+
+	if titleChanged {
+		set = append(set, bson.E{Key: "title_since", Value: now})
+		update = append(update, bson.E{Key: "$push", Value: bson.D{{Key: "title_history", Value: sourcePreviousTitle(stored, now)}}})
+	}
+
+	update = append(update, bson.E{Key: "$set", Value: set})
+
+	_, err := col.UpdateOne(ctx, bson.D{{Key: "_id", Value: stored.ID}}, update)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "UpdateOne failed (Sources index)", map[string]any{
+			"col_name": db_collection_sources,
+			"err":      err,
+			"id":       stored.ID,
+		})
+		return "", errors.Wrap(err, "UpdateOne failed (Source)")
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("UpdateOne OK for Source %s", stored.ID), map[string]any{
+		"col_name":       db_collection_sources,
+		"title_changed":  titleChanged,
+		"title":          source.Title,
+		"previous_title": stored.CanonicalTitle,
+		"latency_ms":     latencyMs(start),
+	})
+
+	return StorageObjectID(stored.ID), nil
+}
+
+func (op *mongoSave) Monitoring(ctx context.Context, uid string, monitored bool) error {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	col := db.Collection(db_collection_sources)
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "monitored", Value: monitored},
+		{Key: "monitored_changed_at", Value: primitive.NewDateTimeFromTime(time.Now().UTC())},
+	}}}
+
+	res, err := col.UpdateOne(ctx, bson.D{{Key: "_id", Value: uid}}, update)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_save", "UpdateOne failed (Sources monitoring)", map[string]any{
+			"col_name":  db_collection_sources,
+			"id":        uid,
+			"monitored": monitored,
+			"err":       err,
+		})
+		return errors.Wrap(err, "UpdateOne failed (Source monitoring)")
+	}
+
+	if res.MatchedCount == 0 {
+		return errSourceNotFound
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", "Source monitoring changed", map[string]any{
+		"col_name":  db_collection_sources,
+		"id":        uid,
+		"monitored": monitored,
+	})
+
+	return nil
+}
+
+// New message is kept pending with other new messages of its collection when batching is enabled,
+// pending messages are inserted before messages of the collection are read or updated.
+func (op *mongoSave) Message(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error) {
+	if err := op.MessageBatched(ctx, c, source, message, nil); err != nil {
+		return "", err
+	}
+	return StorageObjectID(message.MessageUid), nil
+}
+
+func (op *mongoSave) MessageBatched(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE, saved func()) error {
+	storage := op.storage
+
+	colName, _ := storage.messagesCollection(source.SourceUid)
+	err := op.MakeTimeSeries(ctx, colName, "message_created_at")
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "MakeTimeSeries failed (Messages index)", map[string]any{
+			"col_name": colName,
+			"err":      err,
+		})
+		return errors.Wrapf(err, "MakeTimeSeries failed for %s", colName)
+	}
+
+	m := newStoredMessage(c, source, message)
+
+	if storage.batcher != nil {
+		return storage.batcher.Add(ctx, op.logger, colName, m, saved)
+	}
+
+	// Batch of one message, duplicates are checked as they are for batches
+	lost, err := storage.insertMessages(ctx, op.logger, colName, []storedMessage{m})
+	if err != nil {
+		return err
+	}
+
+	if lost[messageKey{m.Metadata.SourceUid, m.ID}] {
+		return errors.New("InsertMany failed (Message)")
+	}
+
+	if saved != nil {
+		saved()
+	}
+
+	return nil
+}
+
+// New message document, with source uid and flags in metadata
+func newStoredMessage(c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) storedMessage {
+	return storedMessage{
+		ID:               message.MessageUid,
+		CreatedAt:        primitive.NewDateTimeFromTime(time.Now().UTC()),
+		MessageCreatedAt: primitive.NewDateTimeFromTime(message.CreatedAt.AsTime()),
+		Metadata: storedMetadata{
+			SourceUid: source.SourceUid,
+			Flags:     message.Flags,
+		},
+		TgID:    messageIDFromUid(message.MessageUid),
+		Message: message,
+		MessageRPC: primitive.Binary{
+			Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+			Data:    c.encodeRpcToBytes(message),
+		},
+	}
+}
+
+func (op *mongoSave) MessageEdit(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error) {
+	start := time.Now()
+
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	colName, sourceFilter := storage.messagesCollection(source.SourceUid)
+
+	// Pending new messages are inserted first, the edited message may be one of them
+	if err := storage.flushMessages(ctx, op.logger, colName); err != nil {
+		return "", errors.Wrap(err, "flush pending messages (Message edit)")
+	}
+
+	col := db.Collection(colName)
+
+	filter := append(sourceFilter,
+		bson.E{Key: "message_created_at", Value: primitive.NewDateTimeFromTime(message.CreatedAt.AsTime())},
+		bson.E{Key: "_id", Value: message.MessageUid},
+	)
+
+	stored := storedMessage{}
+
+	err := col.FindOne(ctx, filter).Decode(&stored)
+	if err == mongo.ErrNoDocuments {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Edited message is not stored, saving as new", map[string]any{
+			"col_name": colName,
+			"id":       message.MessageUid,
+		})
+		return op.Message(ctx, c, source, message)
+	} else if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "FindOne failed (Messages index)", map[string]any{
+			"col_name": colName,
+			"err":      err,
+			"id":       message.MessageUid,
+		})
+		return "", errors.Wrap(err, "FindOne failed (Message edit)")
+	}
+
+	editedAt := primitive.NewDateTimeFromTime(message.EditedAt.AsTime())
+
+	if stored.EditedAt >= editedAt {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Message edit is saved already -- skipped", map[string]any{
+			"col_name": colName,
+			"id":       message.MessageUid,
+		})
+		return StorageObjectID(stored.ID), nil
+	}
+
+	// Deleted flag is kept, message may be edited before deletion is received
+	edited := protobuf_proto.Clone(message).(*proto.FLO_MESSAGE)
+	if stored.DeletedAt != 0 {
+		edited.Flags |= int32(proto.FLAGS_Deleted)
+	}
+
+	update := bson.D{
+		{Key: "$push", Value: bson.D{{Key: "revisions", Value: storedRevision{
+			EditedAt:   stored.EditedAt,
+			MessageRPC: stored.MessageRPC,
+		}}}},
+		{Key: "$set", Value: bson.D{
+			{Key: "edited_at", Value: editedAt},
+			{Key: "metadata.flags", Value: edited.Flags},
+			{Key: "message", Value: edited},
+			{Key: "message_rpc", Value: primitive.Binary{
+				Subtype: STORAGE_BINARY_RPC_SUBTYPE,
+				Data:    c.encodeRpcToBytes(message),
+			}},
+		}},
+	}
+
+	_, err = col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "UpdateOne failed (Messages index)", map[string]any{
+			"col_name": colName,
+			"err":      err,
+			"id":       message.MessageUid,
+		})
+		return "", errors.Wrap(err, "UpdateOne failed (Message edit)")
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("UpdateOne OK for Message edit %s", stored.ID), map[string]any{
+		"col_name":   colName,
+		"revisions":  len(stored.Revisions) + 1,
+		"latency_ms": latencyMs(start),
+	})
+
+	return StorageObjectID(stored.ID), nil
+}
+
+// Messages are found by telegram message id in the messages collection. Per-source collections have messages saved
+// without telegram message id, they are found by message uid in the collection of each source.
+func (op *mongoSave) MessagesDeleted(ctx context.Context, sourceUid string, messageIDs []int, deletedAt time.Time) (int64, error) {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	if len(messageIDs) == 0 {
+		return 0, nil
+	}
+
+	filters := map[string]bson.D{}
+
+	if storage.messages == mongo_messages_per_source {
+		sourceUids := []string{sourceUid}
+		if sourceUid == "" {
+			read := &mongoRead{storage: storage, logger: op.logger}
+
+			sources, err := read.Sources(ctx, sourcesQuery{
+				FilterFlags: []int32{int32(proto.FLAGS_User), int32(proto.FLAGS_Group)},
+			})
+			if err != nil {
+				return 0, errors.Wrap(err, "read user and group sources (Messages deleted)")
+			}
+
+			sourceUids = make([]string, 0, len(sources))
+			for _, source := range sources {
+				sourceUids = append(sourceUids, source.ID)
+			}
+		}
+
+		for _, uid := range sourceUids {
+			messageUids := make([]string, 0, len(messageIDs))
+			for _, id := range messageIDs {
+				messageUids = append(messageUids, makeMessageUid(uid, id))
+			}
+
+			colName, _ := storage.messagesCollection(uid)
+			filters[colName] = bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: messageUids}}}}
+		}
+	} else {
+		filter := bson.D{{Key: "tg_id", Value: bson.D{{Key: "$in", Value: messageIDs}}}}
+		if sourceUid != "" {
+			filter = append(filter, bson.E{Key: "metadata.source_uid", Value: sourceUid})
+		} else {
+			filter = append(filter, bson.E{Key: "metadata.flags", Value: bson.D{{Key: "$bitsAllClear", Value: int32(proto.FLAGS_Channel)}}})
+		}
+		filters[db_collection_messages] = filter
+	}
+
+	deletedFlag := bson.D{{Key: "or", Value: int32(proto.FLAGS_Deleted)}}
+
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: primitive.NewDateTimeFromTime(deletedAt)}}},
+		{Key: "$bit", Value: bson.D{
+			{Key: "message.flags", Value: deletedFlag},
+			{Key: "metadata.flags", Value: deletedFlag},
+		}},
+	}
+
+	count := int64(0)
+
+	for colName, filter := range filters {
+		// Pending new messages are inserted first, deleted messages may be among them
+		if err := storage.flushMessages(ctx, op.logger, colName); err != nil {
+			return count, errors.Wrap(err, "flush pending messages (Messages deleted)")
+		}
+
+		col := db.Collection(colName)
+
+		filter = append(filter, bson.E{Key: "deleted_at", Value: bson.D{{Key: "$exists", Value: false}}})
+
+		res, err := col.UpdateMany(ctx, filter, update)
+		if err != nil {
+			op.logger.Message(gelf.LOG_ALERT, "storage_save", "UpdateMany failed (Messages deleted)", map[string]any{
+				"col_name":   colName,
+				"source_uid": sourceUid,
+				"err":        err,
+				"ids":        fmt.Sprint(messageIDs),
+			})
+			return count, errors.Wrap(err, "UpdateMany failed (Messages deleted)")
+		}
+
+		if res.ModifiedCount > 0 {
+			op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("UpdateMany OK for %d deleted Messages", res.ModifiedCount), map[string]any{
+				"col_name": colName,
+			})
+		}
+
+		count += res.ModifiedCount
+	}
+
+	return count, nil
+}
+
+func (op *mongoSave) Backfill(ctx context.Context, state *storedBackfill) error {
+	storage := op.storage
+
+	// Progress is saved after messages of the page are inserted, so backfill resumes without a gap
+	if storage.batcher != nil {
+		if err := storage.batcher.FlushAll(ctx, op.logger); err != nil {
+			return errors.Wrap(err, "flush pending messages (Backfill)")
+		}
+	}
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	err := op.MakeCollection(ctx, db_collection_backfill)
+	if err != nil {
+		return errors.Wrapf(err, "MakeCollection failed for %s", db_collection_backfill)
+	}
+
+	col := db.Collection(db_collection_backfill)
+
+	state.UpdatedAt = primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	opts := options.Replace().SetUpsert(true)
+
+	_, err = col.ReplaceOne(ctx, bson.D{{Key: "_id", Value: state.ID}}, state, opts)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_save", "ReplaceOne failed (backfill)", map[string]any{
+			"col_name": db_collection_backfill,
+			"id":       state.ID,
+			"err":      err,
+		})
+		return errors.Wrap(err, "ReplaceOne failed (Backfill)")
+	}
+
+	return nil
+}
+
+func (op *mongoSave) MakeTimeSeries(ctx context.Context, colName, timeField string) error {
+	// Timeseries collections must be explicitly created so we explicitly create it here
+	opts := options.CreateCollection().
+		SetTimeSeriesOptions(options.TimeSeries().
+			SetGranularity("hours").
+			SetMetaField("metadata").
+			SetTimeField(timeField))
+
+	return op.makeCollection(ctx, colName, opts, map[string]any{
+		"col_name":       colName,
+		"col_time_field": timeField,
+		"col_type":       "timeseries",
+	})
+}
+
+func (op *mongoSave) MakeCollection(ctx context.Context, colName string) error {
+	return op.makeCollection(ctx, colName, options.CreateCollection(), map[string]any{
+		"col_name": colName,
+		"col_type": "collection",
+	})
+}
+
+// Create collection unless it is in known collections registry
+func (op *mongoSave) makeCollection(ctx context.Context, colName string, opts *options.CreateCollectionOptions, logInfo map[string]any) error {
+	storage := op.storage
+
+	db := storage.mgClient.Database(storage.dbName)
+
+	exists, err := storage.collections.Exists(ctx, db, colName)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Known collections check failed", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.Wrap(err, "Known collections check failed")
+	}
+
+	if exists {
+		return nil
+	}
+
+	err = db.CreateCollection(ctx, colName, opts)
+	if err != nil {
+		// Registry is stale, collection may be created or dropped by another process
+		storage.collections.Invalidate()
+
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.HasErrorCode(48) { // NamespaceExists
+			op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Collection exists already", logInfo)
+			return nil
+		}
+
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Failed to create collection", logInfo, map[string]any{
+			"err": err,
+		})
+		return errors.Wrap(err, "Error creating collection")
+	}
+
+	storage.collections.Add(colName)
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", "Collection created", logInfo)
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"database/sql"
+	"net/url"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+	_ "modernc.org/sqlite"
+)
+
+// Tables are created when missing. Times are unix milliseconds, like primitive.DateTime of stored types.
+// Messages of all sources are in one table, flags and other filtered fields are columns next to the RPC copy.
+const sqlite_schema = `
+CREATE TABLE IF NOT EXISTS sources (
+	id                   TEXT PRIMARY KEY,
+	created_at           INTEGER NOT NULL,
+	updated_at           INTEGER NOT NULL DEFAULT 0,
+	flags                INTEGER NOT NULL,
+	source_rpc           BLOB NOT NULL,
+	canonical_title      TEXT NOT NULL DEFAULT '',
+	title_since          INTEGER NOT NULL DEFAULT 0,
+	title_history        BLOB,
+	monitored            INTEGER NOT NULL DEFAULT 1,
+	monitored_changed_at INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS messages (
+	source_uid           TEXT NOT NULL,
+	id                   TEXT NOT NULL,
+	tg_id                INTEGER NOT NULL,
+	created_at           INTEGER NOT NULL,
+	message_created_at   INTEGER NOT NULL,
+	flags                INTEGER NOT NULL,
+	topic_id             INTEGER NOT NULL DEFAULT 0,
+	author_uid           TEXT NOT NULL DEFAULT '',
+	reply_to_message_uid TEXT NOT NULL DEFAULT '',
+	message_rpc          BLOB NOT NULL,
+	edited_at            INTEGER NOT NULL DEFAULT 0,
+	revisions            BLOB,
+	deleted_at           INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (source_uid, id)
+);
+
+CREATE INDEX IF NOT EXISTS messages_source_created ON messages (source_uid, message_created_at, id);
+CREATE INDEX IF NOT EXISTS messages_source_reply ON messages (source_uid, reply_to_message_uid);
+CREATE INDEX IF NOT EXISTS messages_tg_id ON messages (tg_id);
+
+CREATE TABLE IF NOT EXISTS backfill (
+	id         TEXT PRIMARY KEY,
+	offset_id  INTEGER NOT NULL,
+	processed  INTEGER NOT NULL,
+	total      INTEGER NOT NULL,
+	done       INTEGER NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+`
+
+// Storage in a SQLite database file (pure Go driver), for small deployments without MongoDB
+type storageSQLite struct {
+	logger Logger
+	health *Health
+	db     *sql.DB
+	path   string
+}
+
+func NewStorageSQLite(path string, logger Logger, health *Health) (*storageSQLite, error) {
+	// Storage operations are run one at a time with the Queue, a single connection avoids "database is locked"
+	dsn := "file:" + path + "?" + url.Values{"_pragma": {"journal_mode(WAL)", "busy_timeout(5000)", "synchronous(NORMAL)"}}.Encode()
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, "sql.Open (sqlite)")
+	}
+
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqlite_schema); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "create sqlite schema")
+	}
+
+	return &storageSQLite{
+		logger: logger,
+		health: health,
+		db:     db,
+		path:   path,
+	}, nil
+}
+
+func (storage *storageSQLite) Reader(logger Logger) StorageReader {
+	return &sqliteRead{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+func (storage *storageSQLite) Saver(logger Logger) StorageSaver {
+	return &sqliteSave{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Ping database, result is reported as storage health
+func (storage *storageSQLite) Ping() error {
+	err := storage.db.Ping()
+
+	if err != nil {
+		storage.health.Set(health_storage, false, "sqlite ping failed: "+err.Error())
+	} else {
+		storage.health.Set(health_storage, true, "sqlite ping ok")
+	}
+
+	return err
+}
+
+func (storage *storageSQLite) Close() {
+	if err := storage.db.Close(); err != nil {
+		storage.logger.Message(gelf.LOG_WARNING, "storage", "ERROR Close() sqlite database", map[string]any{
+			"path": storage.path,
+			"err":  err,
+		})
+	}
+}
+
+// Lists are kept in blob columns as bson documents, as they are in MongoDB documents
+type sqliteTitleHistory struct {
+	Items []storedTitle `bson:"items"`
+}
+
+type sqliteRevisions struct {
+	Items []storedRevision `bson:"items"`
+}
+
+func sqliteEncodeList(v any) ([]byte, error) {
+	data, err := bson.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "bson.Marshal (sqlite list)")
+	}
+	return data, nil
+}
+
+func sqliteDecodeList(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if err := bson.Unmarshal(data, v); err != nil {
+		return errors.Wrap(err, "bson.Unmarshal (sqlite list)")
+	}
+	return nil
+}
+
+// Conditions joined with AND, with their arguments
+type sqliteWhere struct {
+	conditions []string
+	args       []any
+}
+
+func (w *sqliteWhere) add(condition string, args ...any) {
+	w.conditions = append(w.conditions, condition)
+	w.args = append(w.args, args...)
+}
+
+// Column in list of values, list must not be empty
+func (w *sqliteWhere) in(column string, values []string) {
+	args := make([]any, 0, len(values))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	w.add(column+" IN ("+sqlitePlaceholders(len(values))+")", args...)
+}
+
+// Flags column has all bits of any filter mask set
+func (w *sqliteWhere) flags(column string, filterFlags []int32) {
+	or := make([]string, 0, len(filterFlags))
+	args := make([]any, 0, len(filterFlags)*2)
+	for _, mask := range filterFlags {
+		or = append(or, "("+column+" & ?) = ?")
+		args = append(args, mask, mask)
+	}
+	w.add("("+strings.Join(or, " OR ")+")", args...)
+}
+
+func (w *sqliteWhere) String() string {
+	if len(w.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.conditions, " AND ")
+}
+
+func sqlitePlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func sqliteBool(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	pb "github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+type sqliteRead struct {
+	storage *storageSQLite
+	logger  Logger
+}
+
+const sqlite_sources_columns = "id, created_at, updated_at, source_rpc, canonical_title, title_since, title_history, monitored, monitored_changed_at"
+
+func (op *sqliteRead) Sources(ctx context.Context, query sourcesQuery) ([]storedSource, error) {
+	where := sqliteWhere{}
+
+	if len(query.Uids) > 0 {
+		where.in("id", query.Uids)
+	}
+
+	if query.AfterID != "" {
+		where.add("id > ?", query.AfterID)
+	}
+
+	if query.MonitoredOnly {
+		where.add("monitored = 1")
+	}
+
+	if len(query.FilterFlags) > 0 {
+		where.flags("flags", query.FilterFlags)
+	}
+
+	q := "SELECT " + sqlite_sources_columns + " FROM sources" + where.String() + " ORDER BY id"
+
+	if query.Limit > 0 {
+		q += " LIMIT ?"
+		where.args = append(where.args, query.Limit)
+	}
+
+	rows, err := op.storage.db.QueryContext(ctx, q, where.args...)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Query failed (sources)", map[string]any{
+			"debug_uids": strings.Join(query.Uids, ","),
+			"err":        err,
+		})
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	result := []storedSource{}
+
+	for rows.Next() {
+		var m storedSource
+		var rpc, titleHistory []byte
+
+		err := rows.Scan(&m.ID, &m.CreatedAt, &m.UpdatedAt, &rpc, &m.CanonicalTitle, &m.TitleSince, &titleHistory, &m.Monitored, &m.MonitoredChangedAt)
+		if err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Scan failed for sources row", map[string]any{
+				"err": err,
+			})
+			return nil, err
+		}
+
+		m.SourceRPC = primitive.Binary{Subtype: STORAGE_BINARY_RPC_SUBTYPE, Data: rpc}
+		m.Source = &pb.FLO_SOURCE{}
+
+		if err := proto.Unmarshal(rpc, m.Source); err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Unmarshal RPC failed (skipped)", map[string]any{
+				"id":  m.ID,
+				"err": err,
+			})
+			continue
+		}
+
+		history := sqliteTitleHistory{}
+		if err := sqliteDecodeList(titleHistory, &history); err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Decode failed for title history (skipped)", map[string]any{
+				"id":  m.ID,
+				"err": err,
+			})
+		}
+		m.TitleHistory = history.Items
+
+		storedSourceResult(&m, query.IncludeTitleHistory)
+
+		result = append(result, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Closing sources rows with error", map[string]any{
+			"err": err,
+		})
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (op *sqliteRead) SourceMonitored(ctx context.Context, uid string) (bool, error) {
+	var monitored bool
+
+	err := op.storage.db.QueryRowContext(ctx, "SELECT monitored FROM sources WHERE id = ?", uid).Scan(&monitored)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Query failed (source monitored)", map[string]any{
+			"id":  uid,
+			"err": err,
+		})
+		return false, err
+	}
+
+	return monitored, nil
+}
+
+func (op *sqliteRead) Backfill(ctx context.Context, sourceUid string) (*storedBackfill, error) {
+	m := &storedBackfill{}
+
+	err := op.storage.db.QueryRowContext(ctx, "SELECT id, offset_id, processed, total, done, updated_at FROM backfill WHERE id = ?", sourceUid).
+		Scan(&m.ID, &m.OffsetID, &m.Processed, &m.Total, &m.Done, &m.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Query failed (backfill)", map[string]any{
+			"id":  sourceUid,
+			"err": err,
+		})
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (op *sqliteRead) Messages(ctx context.Context, query messagesQuery) ([]storedMessage, error) {
+	where := sqliteWhere{}
+
+	where.add("source_uid = ?", query.SourceUid)
+
+	if !query.Since.IsZero() {
+		where.add("message_created_at >= ?", primitive.NewDateTimeFromTime(query.Since))
+	}
+	if !query.Before.IsZero() {
+		where.add("message_created_at < ?", primitive.NewDateTimeFromTime(query.Before))
+	}
+
+	if len(query.FilterFlags) > 0 {
+		where.flags("flags", query.FilterFlags)
+	}
+
+	switch {
+	case query.TopicID == 0:
+	case query.TopicID == 1 && query.Forum:
+		// General topic messages of a forum have no topic id
+		where.add("topic_id <= 1")
+	default:
+		where.add("topic_id = ?", query.TopicID)
+	}
+
+	if query.AuthorUid != "" {
+		where.add("author_uid = ?", query.AuthorUid)
+	}
+
+	switch query.Deleted {
+	case pb.FlotgDeletedFilter_DeletedExclude:
+		where.add("deleted_at = 0")
+	case pb.FlotgDeletedFilter_DeletedOnly:
+		where.add("deleted_at != 0")
+	}
+
+	order := "ASC"
+	next := ">"
+	if query.Descending {
+		order = "DESC"
+		next = "<"
+	}
+
+	// Resume after the cursor position, in sort order: (message_created_at, id)
+	if query.After != nil {
+		after := primitive.NewDateTimeFromTime(query.After.CreatedAt)
+		where.add("(message_created_at "+next+" ? OR (message_created_at = ? AND id "+next+" ?))", after, after, query.After.ID)
+	}
+
+	q := where.String() + " ORDER BY message_created_at " + order + ", id " + order
+
+	if query.Limit > 0 {
+		q += " LIMIT ?"
+		where.args = append(where.args, query.Limit)
+	}
+
+	return op.findMessages(ctx, q, where.args, query.IncludeRevisions)
+}
+
+func (op *sqliteRead) Message(ctx context.Context, sourceUid, messageUid string) (*storedMessage, error) {
+	result, err := op.findMessages(ctx, " WHERE source_uid = ? AND id = ?", []any{sourceUid, messageUid}, false)
+	if err != nil || len(result) == 0 {
+		return nil, err
+	}
+	return &result[0], nil
+}
+
+func (op *sqliteRead) Replies(ctx context.Context, sourceUid string, messageUids []string, limit int64) ([]storedMessage, error) {
+	if len(messageUids) == 0 {
+		return []storedMessage{}, nil
+	}
+
+	where := sqliteWhere{}
+
+	where.add("source_uid = ?", sourceUid)
+	where.in("reply_to_message_uid", messageUids)
+
+	q := where.String() + " ORDER BY message_created_at, id"
+
+	if limit > 0 {
+		q += " LIMIT ?"
+		where.args = append(where.args, limit)
+	}
+
+	return op.findMessages(ctx, q, where.args, false)
+}
+
+// Messages selected with query after FROM (conditions, order and limit)
+func (op *sqliteRead) findMessages(ctx context.Context, query string, args []any, includeRevisions bool) ([]storedMessage, error) {
+	q := "SELECT id, created_at, message_created_at, message_rpc, edited_at, revisions, deleted_at FROM messages" + query
+
+	rows, err := op.storage.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Query failed (messages)", map[string]any{
+			"err": err,
+		})
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	result := []storedMessage{}
+
+	for rows.Next() {
+		var m storedMessage
+		var rpc, revisions []byte
+
+		err := rows.Scan(&m.ID, &m.CreatedAt, &m.MessageCreatedAt, &rpc, &m.EditedAt, &revisions, &m.DeletedAt)
+		if err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Scan failed for messages row", map[string]any{
+				"err": err,
+			})
+			return nil, err
+		}
+
+		m.MessageRPC = primitive.Binary{Subtype: STORAGE_BINARY_RPC_SUBTYPE, Data: rpc}
+		m.Message = &pb.FLO_MESSAGE{}
+
+		if err := proto.Unmarshal(rpc, m.Message); err != nil {
+			op.logger.Message(gelf.LOG_ERR, "storage_read", "Unmarshal RPC failed (skipped)", map[string]any{
+				"id":  m.ID,
+				"err": err,
+			})
+			continue
+		}
+
+		if includeRevisions {
+			stored := sqliteRevisions{}
+			if err := sqliteDecodeList(revisions, &stored); err != nil {
+				op.logger.Message(gelf.LOG_ERR, "storage_read", "Decode failed for revisions (skipped)", map[string]any{
+					"id":  m.ID,
+					"err": err,
+				})
+			}
+			m.Revisions = stored.Items
+		}
+
+		storedMessageResult(op.logger, &m, includeRevisions)
+
+		result = append(result, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_read", "Closing messages rows with error", map[string]any{
+			"err": err,
+		})
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/go-faster/errors"
+	protobuf_proto "github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+type sqliteSave struct {
+	storage *storageSQLite
+	logger  Logger
+}
+
+func (op *sqliteSave) Source(ctx context.Context, c *converter, source *proto.FLO_SOURCE) (StorageObjectID, error) {
+	db := op.storage.db
+
+	stored := storedSource{ID: source.SourceUid, Source: &proto.FLO_SOURCE{}}
+	var rpc, titleHistory []byte
+
+	err := db.QueryRowContext(ctx, "SELECT created_at, source_rpc, canonical_title, title_since, title_history, monitored FROM sources WHERE id = ?", source.SourceUid).
+		Scan(&stored.CreatedAt, &rpc, &stored.CanonicalTitle, &stored.TitleSince, &titleHistory, &stored.Monitored)
+	if err == nil {
+		err = protobuf_proto.Unmarshal(rpc, stored.Source)
+	}
+	if err == nil {
+		history := sqliteTitleHistory{}
+		err = sqliteDecodeList(titleHistory, &history)
+		stored.TitleHistory = history.Items
+	}
+	if err == nil {
+		return op.sourceUpdate(ctx, c, &stored, source)
+	} else if err != sql.ErrNoRows {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Query failed (sources)", map[string]any{
+			"err": err,
+			"id":  source.SourceUid,
+		})
+		return "", errors.Wrap(err, "Query failed (Source)")
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	_, err = db.ExecContext(ctx, "INSERT INTO sources (id, created_at, flags, source_rpc, canonical_title, title_since, monitored) VALUES (?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO NOTHING",
+		source.SourceUid, now, source.Flags, c.encodeRpcToBytes(source), source.Title, now,
+		sqliteBool(source.Monitored), // only for new source, existing source state is kept
+	)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Insert failed (sources)", map[string]any{
+			"err":        err,
+			"debug_json": c.encodeToJson(source, true),
+		})
+		return "", errors.Wrap(err, "Insert failed (Source)")
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("Insert OK for Source %s", source.SourceUid))
+
+	return StorageObjectID(source.SourceUid), nil
+}
+
+func (op *sqliteSave) sourceUpdate(ctx context.Context, c *converter, stored *storedSource, source *proto.FLO_SOURCE) (StorageObjectID, error) {
+	changed, titleChanged := sourceChanges(stored, source)
+
+	if !changed {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Source is not changed (sources)", map[string]any{
+			"id": stored.ID,
+		})
+		return StorageObjectID(stored.ID), nil
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	titleSince := stored.TitleSince
+	if titleChanged {
+		stored.TitleHistory = append(stored.TitleHistory, sourcePreviousTitle(stored, now))
+		titleSince = now
+	}
+
+	titleHistory, err := sqliteEncodeList(sqliteTitleHistory{Items: stored.TitleHistory})
+	if err != nil {
+		return "", err
+	}
+
+	_, err = op.storage.db.ExecContext(ctx, "UPDATE sources SET flags = ?, source_rpc = ?, canonical_title = ?, title_since = ?, title_history = ?, updated_at = ? WHERE id = ?",
+		source.Flags, c.encodeRpcToBytes(source), source.Title, titleSince, titleHistory, now, stored.ID)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Update failed (sources)", map[string]any{
+			"err": err,
+			"id":  stored.ID,
+		})
+		return "", errors.Wrap(err, "Update failed (Source)")
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("Update OK for Source %s", stored.ID), map[string]any{
+		"title_changed":  titleChanged,
+		"title":          source.Title,
+		"previous_title": stored.CanonicalTitle,
+	})
+
+	return StorageObjectID(stored.ID), nil
+}
+
+func (op *sqliteSave) Monitoring(ctx context.Context, uid string, monitored bool) error {
+	res, err := op.storage.db.ExecContext(ctx, "UPDATE sources SET monitored = ?, monitored_changed_at = ? WHERE id = ?",
+		sqliteBool(monitored), primitive.NewDateTimeFromTime(time.Now().UTC()), uid)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_save", "Update failed (sources monitoring)", map[string]any{
+			"id":        uid,
+			"monitored": monitored,
+			"err":       err,
+		})
+		return errors.Wrap(err, "Update failed (Source monitoring)")
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errSourceNotFound
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", "Source monitoring changed", map[string]any{
+		"id":        uid,
+		"monitored": monitored,
+	})
+
+	return nil
+}
+
+func (op *sqliteSave) Message(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error) {
+	res, err := op.storage.db.ExecContext(ctx, "INSERT INTO messages "+
+		"(source_uid, id, tg_id, created_at, message_created_at, flags, topic_id, author_uid, reply_to_message_uid, message_rpc) "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (source_uid, id) DO NOTHING",
+		source.SourceUid,
+		message.MessageUid,
+		messageIDFromUid(message.MessageUid),
+		primitive.NewDateTimeFromTime(time.Now().UTC()),
+		primitive.NewDateTimeFromTime(message.CreatedAt.AsTime()),
+		message.Flags,
+		message.TopicId,
+		message.GetAuthor().GetSourceUid(),
+		message.ReplyToMessageUid,
+		c.encodeRpcToBytes(message),
+	)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Insert failed (messages)", map[string]any{
+			"source_uid": source.SourceUid,
+			"err":        err,
+			"debug_json": c.encodeToJson(message, true),
+		})
+		return "", errors.Wrap(err, "Insert failed (Message)")
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Message exists already -- skipped (messages)", map[string]any{
+			"source_uid": source.SourceUid,
+			"id":         message.MessageUid,
+		})
+		return StorageObjectID(message.MessageUid), nil
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("Insert OK for Message %s", message.MessageUid), map[string]any{
+		"source_uid": source.SourceUid,
+	})
+
+	return StorageObjectID(message.MessageUid), nil
+}
+
+// New messages are saved one at a time
+func (op *sqliteSave) MessageBatched(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE, saved func()) error {
+	if _, err := op.Message(ctx, c, source, message); err != nil {
+		return err
+	}
+	if saved != nil {
+		saved()
+	}
+	return nil
+}
+
+func (op *sqliteSave) MessageEdit(ctx context.Context, c *converter, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE) (StorageObjectID, error) {
+	db := op.storage.db
+
+	var storedEditedAt primitive.DateTime
+	var storedRPC, storedRevisions []byte
+
+	err := db.QueryRowContext(ctx, "SELECT edited_at, message_rpc, revisions FROM messages WHERE source_uid = ? AND id = ?", source.SourceUid, message.MessageUid).
+		Scan(&storedEditedAt, &storedRPC, &storedRevisions)
+	if err == sql.ErrNoRows {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Edited message is not stored, saving as new", map[string]any{
+			"source_uid": source.SourceUid,
+			"id":         message.MessageUid,
+		})
+		return op.Message(ctx, c, source, message)
+	} else if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Query failed (messages)", map[string]any{
+			"source_uid": source.SourceUid,
+			"err":        err,
+			"id":         message.MessageUid,
+		})
+		return "", errors.Wrap(err, "Query failed (Message edit)")
+	}
+
+	editedAt := primitive.NewDateTimeFromTime(message.EditedAt.AsTime())
+
+	if storedEditedAt >= editedAt {
+		op.logger.Message(gelf.LOG_DEBUG, "storage_save", "Message edit is saved already -- skipped", map[string]any{
+			"source_uid": source.SourceUid,
+			"id":         message.MessageUid,
+		})
+		return StorageObjectID(message.MessageUid), nil
+	}
+
+	revisions := sqliteRevisions{}
+	if err := sqliteDecodeList(storedRevisions, &revisions); err != nil {
+		return "", err
+	}
+
+	revisions.Items = append(revisions.Items, storedRevision{
+		EditedAt:   storedEditedAt,
+		MessageRPC: primitive.Binary{Subtype: STORAGE_BINARY_RPC_SUBTYPE, Data: storedRPC},
+	})
+
+	encoded, err := sqliteEncodeList(revisions)
+	if err != nil {
+		return "", err
+	}
+
+	// Deleted flag is kept, message may be edited before deletion is received
+	_, err = db.ExecContext(ctx, "UPDATE messages SET "+
+		"edited_at = ?, revisions = ?, message_rpc = ?, flags = ? | (flags & ?), topic_id = ?, author_uid = ?, reply_to_message_uid = ? "+
+		"WHERE source_uid = ? AND id = ?",
+		editedAt,
+		encoded,
+		c.encodeRpcToBytes(message),
+		message.Flags,
+		int32(proto.FLAGS_Deleted),
+		message.TopicId,
+		message.GetAuthor().GetSourceUid(),
+		message.ReplyToMessageUid,
+		source.SourceUid,
+		message.MessageUid,
+	)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Update failed (messages)", map[string]any{
+			"source_uid": source.SourceUid,
+			"err":        err,
+			"id":         message.MessageUid,
+		})
+		return "", errors.Wrap(err, "Update failed (Message edit)")
+	}
+
+	op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("Update OK for Message edit %s", message.MessageUid), map[string]any{
+		"source_uid": source.SourceUid,
+		"revisions":  len(revisions.Items),
+	})
+
+	return StorageObjectID(message.MessageUid), nil
+}
+
+func (op *sqliteSave) MessagesDeleted(ctx context.Context, sourceUid string, messageIDs []int, deletedAt time.Time) (int64, error) {
+	if len(messageIDs) == 0 {
+		return 0, nil
+	}
+
+	where := sqliteWhere{}
+
+	if sourceUid != "" {
+		where.add("source_uid = ?", sourceUid)
+	} else {
+		where.add("(flags & ?) = 0", int32(proto.FLAGS_Channel))
+	}
+	where.add("deleted_at = 0")
+
+	ids := make([]any, 0, len(messageIDs))
+	for _, id := range messageIDs {
+		ids = append(ids, id)
+	}
+	where.add("tg_id IN ("+sqlitePlaceholders(len(ids))+")", ids...)
+
+	args := append([]any{primitive.NewDateTimeFromTime(deletedAt), int32(proto.FLAGS_Deleted)}, where.args...)
+
+	res, err := op.storage.db.ExecContext(ctx, "UPDATE messages SET deleted_at = ?, flags = flags | ?"+where.String(), args...)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ALERT, "storage_save", "Update failed (messages deleted)", map[string]any{
+			"source_uid": sourceUid,
+			"err":        err,
+			"ids":        fmt.Sprint(messageIDs),
+		})
+		return 0, errors.Wrap(err, "Update failed (Messages deleted)")
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "RowsAffected (Messages deleted)")
+	}
+
+	if count > 0 {
+		op.logger.Message(gelf.LOG_INFO, "storage_save", fmt.Sprintf("Update OK for %d deleted Messages", count), map[string]any{
+			"source_uid": sourceUid,
+		})
+	}
+
+	return count, nil
+}
+
+func (op *sqliteSave) Backfill(ctx context.Context, state *storedBackfill) error {
+	state.UpdatedAt = primitive.NewDateTimeFromTime(time.Now().UTC())
+
+	_, err := op.storage.db.ExecContext(ctx, "INSERT OR REPLACE INTO backfill (id, offset_id, processed, total, done, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		state.ID, state.OffsetID, state.Processed, state.Total, sqliteBool(state.Done), state.UpdatedAt)
+	if err != nil {
+		op.logger.Message(gelf.LOG_ERR, "storage_save", "Insert failed (backfill)", map[string]any{
+			"id":  state.ID,
+			"err": err,
+		})
+		return errors.Wrap(err, "Insert failed (Backfill)")
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/flogram-lab/wayout/flo_tg/proto"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -10,13 +12,83 @@ type storedSource struct {
 	CreatedAt primitive.DateTime `bson:"created_at"`
 	Source    *proto.FLO_SOURCE  `bson:"source"`
 	SourceRPC primitive.Binary   `bson:"source_rpc"`
-	//CanonicalTitle string TODO: track sources Title changes
+	UpdatedAt primitive.DateTime `bson:"updated_at,omitempty"`
+
+	// Current title, kept when source is seen with empty title
+	CanonicalTitle string             `bson:"canonical_title"`
+	TitleSince     primitive.DateTime `bson:"title_since,omitempty"`
+	TitleHistory   []storedTitle      `bson:"title_history,omitempty"`
+
+	Monitored          bool               `bson:"monitored"`
+	MonitoredChangedAt primitive.DateTime `bson:"monitored_changed_at,omitempty"`
+}
+
+// Past title of a source
+type storedTitle struct {
+	Title string             `bson:"title"`
+	Since primitive.DateTime `bson:"since"`
+	Until primitive.DateTime `bson:"until"`
 }
 
 type storedMessage struct {
 	ID               string             `bson:"_id"`
 	CreatedAt        primitive.DateTime `bson:"created_at"`
 	MessageCreatedAt primitive.DateTime `bson:"message_created_at"`
+	Metadata         storedMetadata     `bson:"metadata"` // timeseries meta field
+	TgID             int                `bson:"tg_id"`    // telegram message id, deleted messages of users and groups are found by it
 	Message          *proto.FLO_MESSAGE `bson:"message"`
 	MessageRPC       primitive.Binary   `bson:"message_rpc"`
+	EditedAt         primitive.DateTime `bson:"edited_at,omitempty"`
+	Revisions        []storedRevision   `bson:"revisions,omitempty"`
+	DeletedAt        primitive.DateTime `bson:"deleted_at,omitempty"` // message is kept when deleted in telegram
+}
+
+// Message source and flags, messages of all sources are kept in one timeseries collection
+type storedMetadata struct {
+	SourceUid string `bson:"source_uid"`
+	Flags     int32  `bson:"flags"`
+}
+
+// Previous version of an edited message
+type storedRevision struct {
+	EditedAt   primitive.DateTime `bson:"edited_at,omitempty"` // zero for the original version
+	MessageRPC primitive.Binary   `bson:"message_rpc"`
+}
+
+// Progress of history backfill for a source, saved after each page to resume
+type storedBackfill struct {
+	ID        string             `bson:"_id"` // source uid
+	OffsetID  int                `bson:"offset_id"`
+	Processed int                `bson:"processed"`
+	Total     int                `bson:"total"`
+	Done      bool               `bson:"done"`
+	UpdatedAt primitive.DateTime `bson:"updated_at"`
+}
+
+// Query parameters for StorageReader.Sources. Zero values mean "not set".
+type sourcesQuery struct {
+	Uids          []string
+	AfterID       string // sources are ordered by _id
+	Limit         int64
+	MonitoredOnly bool
+	FilterFlags   []int32
+
+	IncludeTitleHistory bool
+}
+
+// Query parameters for StorageReader.Messages. Zero values mean "not set".
+type messagesQuery struct {
+	SourceUid   string
+	Since       time.Time // inclusive
+	Before      time.Time // exclusive
+	Limit       int64
+	Descending  bool
+	After       *messagesCursor
+	FilterFlags []int32
+	TopicID     int32
+	Forum       bool // source is a forum: General topic (1) also matches messages without topic
+	AuthorUid   string
+
+	IncludeRevisions bool
+	Deleted          proto.FlotgDeletedFilter
 }
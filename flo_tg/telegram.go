@@ -12,6 +12,7 @@ import (
 	"time"
 
 	pebbledb "github.com/cockroachdb/pebble"
+	"github.com/flogram-lab/wayout/flo_tg/proto"
 	"github.com/go-faster/errors"
 	boltstor "github.com/gotd/contrib/bbolt"
 	"github.com/gotd/contrib/middleware/floodwait"
@@ -31,6 +32,8 @@ import (
 	lj "gopkg.in/natefinch/lumberjack.v2"
 )
 
+const telegram_ping_interval = time.Minute
+
 func CreateAndRunTelegramClient(ctx context.Context, bootstrap Bootstrap) error {
 
 	// Setting up logging to file with rotation.
@@ -107,15 +110,44 @@ func CreateAndRunTelegramClient(ctx context.Context, bootstrap Bootstrap) error
 	api := client.API()
 
 	// Authentication flow handles authentication process, like prompting for code and 2FA password.
-	flow := auth.NewFlow(examples.Terminal{PhoneNumber: bootstrap.TgPhone}, auth.SendCodeOptions{})
+	// With TG_AUTH=rpc, values are submitted with RPC calls instead of terminal prompts.
+	var authenticator auth.UserAuthenticator = examples.Terminal{PhoneNumber: bootstrap.TgPhone}
+	if bootstrap.TelegramAuth.rpc {
+		authenticator = bootstrap.TelegramAuth
+	}
+	flow := auth.NewFlow(authenticator, auth.SendCodeOptions{})
+
+	health := bootstrap.Health
+	health.Set(health_telegram, false, "connecting")
+	defer health.Set(health_telegram, false, "stopped")
 
 	return waiter.Run(ctx, func(ctx context.Context) error {
 		// Spawning main goroutine.
 		if err := client.Run(ctx, func(ctx context.Context) error {
+			health.Set(health_telegram, false, "connected, checking authorization")
+
 			// Perform auth if no session is available.
-			if err := client.Auth().IfNecessary(ctx, flow); err != nil {
-				return errors.Wrap(err, "auth")
+			// Login over RPC is retried after a failed attempt (e.g. wrong code).
+			bootstrap.TelegramAuth.setState(proto.FlotgAuthState_AuthChecking)
+			for {
+				err := client.Auth().IfNecessary(ctx, flow)
+				if err == nil {
+					break
+				}
+
+				bootstrap.TelegramAuth.failed(err)
+				health.Set(health_telegram, false, "login failed: "+err.Error())
+
+				if !bootstrap.TelegramAuth.rpc || ctx.Err() != nil {
+					return errors.Wrap(err, "auth")
+				}
+
+				bootstrap.Logger.Message(gelf.LOG_WARNING, "telegram", "Login failed, waiting for new login over RPC", map[string]any{
+					"err": err.Error(),
+				})
 			}
+			bootstrap.TelegramAuth.authorized()
+			health.Set(health_telegram, true, "connected, authorized")
 
 			// Getting info about current user.
 			self, err := client.Self(ctx)
@@ -142,12 +174,20 @@ func CreateAndRunTelegramClient(ctx context.Context, bootstrap Bootstrap) error
 			handling := newTelegramHandling(bootstrap, peerDB, self)
 			handling.Attach(dispatcher)
 
+			bootstrap.Telegram.set(api, handling)
+			defer bootstrap.Telegram.clear()
+
+			go pingTelegram(ctx, client, health)
+			go handling.attachments.Run(ctx, api)
+
 			// Waiting until context is done.
 			bootstrap.Logger.Message(gelf.LOG_DEBUG, "telegram", "Listening for updates. Interrupt (Ctrl+C) to stop.")
+			defer health.Set(health_updates, false, "stopped")
 			return updatesRecovery.Run(ctx, api, self.ID, updates.AuthOptions{
 				IsBot: self.Bot,
 				OnStart: func(ctx context.Context) {
 					handling.bootstrap.Logger.Message(gelf.LOG_INFO, "telegram", "Update recovery initialized and started, listening for events")
+					health.Set(health_updates, true, "listening for updates")
 				},
 			})
 		}); err != nil {
@@ -156,3 +196,22 @@ func CreateAndRunTelegramClient(ctx context.Context, bootstrap Bootstrap) error
 		return nil
 	})
 }
+
+// Goroutine that pings telegram every [telegram_ping_interval] until context is done, result is reported as telegram health
+func pingTelegram(ctx context.Context, client *telegram.Client, health *Health) {
+	ticker := time.NewTicker(telegram_ping_interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := client.Ping(ctx); err != nil {
+				health.Set(health_telegram, false, "ping failed: "+err.Error())
+			} else {
+				health.Set(health_telegram, true, "connected, authorized")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
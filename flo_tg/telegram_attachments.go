@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/gotd/td/telegram/downloader"
+	"github.com/gotd/td/tg"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+const attachments_download_backlog = 1000
+
+// Attachment file to download with Telegram API
+type attachmentDownload struct {
+	AttachmentUid string
+	Size          int64
+	Location      tg.InputFileLocationClass
+}
+
+// attachmentDownloads saves attachment files to blob store in background, one file at a time.
+// Files are downloaded soon after message is received, while file reference of the message is valid.
+type attachmentDownloads struct {
+	bootstrap Bootstrap
+	pending   chan *attachmentDownload
+}
+
+func newAttachmentDownloads(bootstrap Bootstrap) *attachmentDownloads {
+	return &attachmentDownloads{
+		bootstrap: bootstrap,
+		pending:   make(chan *attachmentDownload, attachments_download_backlog),
+	}
+}
+
+// Add attachment to download, never blocks: attachment is skipped when blob store is not set,
+// when it is larger than max size, or when there are too many pending downloads.
+func (d *attachmentDownloads) Enqueue(download *attachmentDownload, logger Logger) {
+	if d.bootstrap.Blobs == nil {
+		return
+	}
+
+	logInfo := map[string]any{
+		"attachment_uid": download.AttachmentUid,
+		"size":           download.Size,
+	}
+
+	if max := d.bootstrap.AttachmentsMaxSize; max > 0 && download.Size > max {
+		logger.Message(gelf.LOG_INFO, "telegram_attachments", "Attachment is too large, download skipped", logInfo)
+		return
+	}
+
+	select {
+	case d.pending <- download:
+	default:
+		logger.Message(gelf.LOG_WARNING, "telegram_attachments", "Too many pending downloads, attachment download skipped", logInfo)
+	}
+}
+
+// Goroutine downloading pending attachments until context is done
+func (d *attachmentDownloads) Run(ctx context.Context, api *tg.Client) {
+	dl := downloader.NewDownloader()
+
+	for {
+		select {
+		case download := <-d.pending:
+			d.download(ctx, api, dl, download)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *attachmentDownloads) download(ctx context.Context, api *tg.Client, dl *downloader.Downloader, download *attachmentDownload) {
+	blobs := d.bootstrap.Blobs
+	logger := d.bootstrap.Logger
+
+	logInfo := map[string]any{
+		"attachment_uid": download.AttachmentUid,
+		"size":           download.Size,
+	}
+
+	// Same media is attached to forwarded and reposted messages
+	exists, err := blobs.Exists(ctx, download.AttachmentUid)
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "telegram_attachments", "Blob store check failed, attachment download skipped", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+		return
+	}
+
+	if exists {
+		logger.Message(gelf.LOG_DEBUG, "telegram_attachments", "Attachment is downloaded already", logInfo)
+		return
+	}
+
+	r, w := io.Pipe()
+
+	go func() {
+		_, err := dl.Download(api, download.Location).Stream(ctx, w)
+		w.CloseWithError(err)
+	}()
+
+	err = blobs.Put(ctx, download.AttachmentUid, r)
+	r.CloseWithError(err) // stops download if blob store failed
+
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "telegram_attachments", "Attachment download failed", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+		return
+	}
+
+	logger.Message(gelf.LOG_INFO, "telegram_attachments", "Attachment downloaded", logInfo)
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/auth"
+	"github.com/gotd/td/tg"
+)
+
+const (
+	tg_auth_mode_terminal = "terminal"
+	tg_auth_mode_rpc      = "rpc"
+)
+
+// TelegramAuth tracks telegram login state.
+// With TG_AUTH=rpc it is also the auth.UserAuthenticator of login flow: each step blocks until the value is submitted with RPC.
+type TelegramAuth struct {
+	mu        sync.Mutex
+	phone     string
+	rpc       bool
+	state     proto.FlotgAuthState
+	lastError string
+
+	begin    chan struct{}
+	code     chan string
+	password chan string
+}
+
+var _ auth.UserAuthenticator = (*TelegramAuth)(nil)
+
+func NewTelegramAuth(phone string, rpc bool) *TelegramAuth {
+	return &TelegramAuth{
+		phone:    phone,
+		rpc:      rpc,
+		state:    proto.FlotgAuthState_AuthUnknown,
+		begin:    make(chan struct{}, 1),
+		code:     make(chan string, 1),
+		password: make(chan string, 1),
+	}
+}
+
+func (a *TelegramAuth) setState(state proto.FlotgAuthState) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.state = state
+}
+
+// Login attempt failed, error is reported in status
+func (a *TelegramAuth) failed(err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.state = proto.FlotgAuthState_AuthFailed
+	a.lastError = err.Error()
+}
+
+func (a *TelegramAuth) authorized() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.state = proto.FlotgAuthState_AuthAuthorized
+	a.lastError = ""
+}
+
+func (a *TelegramAuth) Status() *proto.FlotgAuthStatus {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return &proto.FlotgAuthStatus{
+		State:           a.state,
+		Error:           a.lastError,
+		RpcLoginEnabled: a.rpc,
+	}
+}
+
+// Submit value for the login step, if flow is waiting for it
+func (a *TelegramAuth) submit(waiting proto.FlotgAuthState, send func() bool) error {
+	if !a.rpc {
+		return errors.New("login over RPC is not enabled, set TG_AUTH=rpc")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.state != waiting {
+		return errors.Errorf("login is not waiting for this step, state is %s", a.state)
+	}
+
+	if !send() {
+		return errors.New("login step is already submitted")
+	}
+
+	a.state = proto.FlotgAuthState_AuthSigningIn
+
+	return nil
+}
+
+func (a *TelegramAuth) Begin(phone string) error {
+	if phone != "" && sessionFolder(phone) != sessionFolder(a.phone) {
+		return errors.New("phone is not the same as TG_PHONE")
+	}
+
+	return a.submit(proto.FlotgAuthState_AuthWaitingBegin, func() bool {
+		select {
+		case a.begin <- struct{}{}:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+func (a *TelegramAuth) SubmitCode(code string) error {
+	return a.submit(proto.FlotgAuthState_AuthWaitingCode, func() bool {
+		select {
+		case a.code <- code:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+func (a *TelegramAuth) SubmitPassword(password string) error {
+	return a.submit(proto.FlotgAuthState_AuthWaitingPassword, func() bool {
+		select {
+		case a.password <- password:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+func (a *TelegramAuth) Phone(ctx context.Context) (string, error) {
+	a.setState(proto.FlotgAuthState_AuthWaitingBegin)
+
+	select {
+	case <-a.begin:
+		a.setState(proto.FlotgAuthState_AuthSendingCode)
+		return a.phone, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (a *TelegramAuth) Code(ctx context.Context, _ *tg.AuthSentCode) (string, error) {
+	a.setState(proto.FlotgAuthState_AuthWaitingCode)
+
+	select {
+	case code := <-a.code:
+		return code, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (a *TelegramAuth) Password(ctx context.Context) (string, error) {
+	a.setState(proto.FlotgAuthState_AuthWaitingPassword)
+
+	select {
+	case password := <-a.password:
+		return password, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (a *TelegramAuth) AcceptTermsOfService(ctx context.Context, tos tg.HelpTermsOfService) error {
+	return &auth.SignUpRequired{TermsOfService: tos}
+}
+
+func (a *TelegramAuth) SignUp(ctx context.Context) (auth.UserInfo, error) {
+	return auth.UserInfo{}, errors.New("sign up is not supported, use an existing telegram account")
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/go-faster/errors"
+	"github.com/gotd/contrib/storage"
+	"github.com/gotd/td/telegram/query/dialogs"
+	"github.com/gotd/td/tg"
+	"gopkg.in/Graylog2/go-gelf.v2/gelf"
+)
+
+// Maximum limit of messages.getHistory
+const backfill_page_size = 100
+
+// Peer of a stored source from peer storage.
+// Source flags tell peer kind, all kinds are tried if flags do not.
+func (handling *telegramHandling) sourcePeer(ctx context.Context, source *proto.FLO_SOURCE) (storage.Peer, error) {
+	id := proto.GetSourceID(source)
+
+	var kinds []dialogs.PeerKind
+
+	switch {
+	case source.Flags&int32(proto.FLAGS_Channel) != 0:
+		kinds = []dialogs.PeerKind{dialogs.Channel}
+	case source.Flags&int32(proto.FLAGS_Group) != 0:
+		kinds = []dialogs.PeerKind{dialogs.Chat}
+	case source.Flags&int32(proto.FLAGS_User) != 0:
+		kinds = []dialogs.PeerKind{dialogs.User}
+	default:
+		kinds = []dialogs.PeerKind{dialogs.Channel, dialogs.Chat, dialogs.User}
+	}
+
+	for _, kind := range kinds {
+		peer, err := handling.peerDB.Find(ctx, storage.PeerKey{Kind: kind, ID: id})
+		if errors.Is(err, storage.ErrPeerNotFound) {
+			continue
+		}
+		return peer, err
+	}
+
+	return storage.Peer{}, storage.ErrPeerNotFound
+}
+
+// Entities of API response, users and chats are also added to peer storage
+func (handling *telegramHandling) collectEntities(ctx context.Context, users []tg.UserClass, chats []tg.ChatClass) tg.Entities {
+	e := tg.Entities{
+		Users:    map[int64]*tg.User{},
+		Chats:    map[int64]*tg.Chat{},
+		Channels: map[int64]*tg.Channel{},
+	}
+
+	for _, u := range users {
+		user, ok := u.AsNotEmpty()
+		if !ok {
+			continue
+		}
+
+		e.Users[user.ID] = user
+
+		var peer storage.Peer
+		if peer.FromUser(user) {
+			_ = handling.peerDB.Add(ctx, peer)
+		}
+	}
+
+	for _, c := range chats {
+		switch chat := c.(type) {
+		case *tg.Chat:
+			e.Chats[chat.ID] = chat
+		case *tg.Channel:
+			e.Channels[chat.ID] = chat
+		}
+
+		var peer storage.Peer
+		if peer.FromChat(c) {
+			_ = handling.peerDB.Add(ctx, peer)
+		}
+	}
+
+	return e
+}
+
+// Backfill pages through peer history from state.OffsetID to the oldest message (newest first).
+// Each page is saved as new messages in a single queue operation, followed by state saved to resume later.
+// Stops when history is done, when [maxMessages] is processed (if non-zero), or on error.
+// Progress is called after each page.
+func (handling *telegramHandling) Backfill(ctx context.Context, api *tg.Client, peer storage.Peer, state *storedBackfill, maxMessages int, progress func(*storedBackfill) error) error {
+	logger := handling.bootstrap.Logger.AddRequestID(fmt.Sprintf("td-backfill-%s-%s", state.ID, RandStringBytesMaskImprSrcSB(8)))
+
+	const handler = "backfill"
+
+	logInfo := map[string]any{
+		"handler":      handler,
+		"source_uid":   state.ID,
+		"offset_id":    state.OffsetID,
+		"max_messages": maxMessages,
+	}
+
+	logger.Message(gelf.LOG_INFO, "telegram_backfill", "Backfill started", logInfo)
+
+	processed := 0
+
+	for !state.Done && (maxMessages == 0 || processed < maxMessages) {
+		res, err := api.MessagesGetHistory(ctx, &tg.MessagesGetHistoryRequest{
+			Peer:     peer.AsInputPeer(),
+			OffsetID: state.OffsetID,
+			Limit:    backfill_page_size,
+		})
+		if err != nil {
+			logger.Message(gelf.LOG_ERR, "telegram_backfill", "messages.getHistory failed", logInfo, map[string]any{
+				"err": err.Error(),
+			})
+			return errors.Wrap(err, "get history")
+		}
+
+		history, ok := res.AsModified()
+		if !ok {
+			return errors.New("get history: not modified")
+		}
+
+		e := handling.collectEntities(ctx, history.GetUsers(), history.GetChats())
+		page := history.GetMessages()
+
+		var saveErr error
+
+		handling.bootstrap.Queue.EnqueueAndWait(func(ctx context.Context) {
+			saveErr = handling.backfillPage(ctx, peer, e, page, logger, logInfo)
+			if saveErr != nil {
+				return
+			}
+
+			// History is ordered newest first, next page is before the last message
+			if len(page) > 0 {
+				state.OffsetID = page[len(page)-1].GetID()
+				state.Processed += len(page)
+			}
+
+			if counted, ok := res.(interface{ GetCount() int }); ok {
+				state.Total = counted.GetCount()
+			} else {
+				state.Total = state.Processed
+			}
+
+			state.Done = len(page) == 0 || state.OffsetID <= 1
+
+			save := handling.bootstrap.Storage.Saver(logger)
+
+			saveErr = save.Backfill(ctx, state)
+		})
+
+		if saveErr != nil {
+			logger.Message(gelf.LOG_ERR, "telegram_backfill", "Backfill page save failed", logInfo, map[string]any{
+				"err": saveErr.Error(),
+			})
+			return errors.Wrap(saveErr, "save page")
+		}
+
+		processed += len(page)
+		logInfo["offset_id"] = state.OffsetID
+		logInfo["processed"] = state.Processed
+
+		if err := progress(state); err != nil {
+			return err
+		}
+	}
+
+	logger.Message(gelf.LOG_INFO, "telegram_backfill", "Backfill stopped", logInfo, map[string]any{
+		"done": state.Done,
+	})
+
+	return nil
+}
+
+// Save source once and messages of a history page of a monitored source.
+// History messages are not published to Feed, and their attachments are not downloaded.
+func (handling *telegramHandling) backfillPage(ctx context.Context, peer storage.Peer, e tg.Entities, page []tg.MessageClass, logger Logger, logInfo map[string]any) error {
+	// Peer is updated with page entities
+	if found, err := handling.peerDB.Find(ctx, storage.KeyFromPeer(peer)); err == nil {
+		peer = found
+	}
+
+	source, deepFromId := handling.converter.makeProtoSource(nil, peer, e, handling.selfUser)
+
+	save := handling.bootstrap.Storage.Saver(logger)
+
+	// Source is saved even if not monitored, so it can be found and turned on
+	source.Monitored = handling.bootstrap.MonitorNewSources
+
+	if _, err := save.Source(ctx, handling.converter, source); err != nil {
+		logger.Message(gelf.LOG_CRIT, "telegram_backfill", "Source storage failed", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	read := handling.bootstrap.Storage.Reader(logger)
+
+	monitored, err := read.SourceMonitored(ctx, source.SourceUid)
+	if err != nil {
+		logger.Message(gelf.LOG_CRIT, "telegram_backfill", "Source monitoring state read failed", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	if !monitored {
+		logger.Message(gelf.LOG_DEBUG, "telegram_backfill", "Source is not monitored, page skipped", logInfo)
+		return nil
+	}
+
+	for _, m := range page {
+		var message *proto.FLO_MESSAGE
+
+		switch msg := m.(type) {
+		case *tg.Message:
+			message = handling.converter.makeProtoMessage(ctx, msg, source, deepFromId, e, handling.selfUser)
+		case *tg.MessageService:
+			message = handling.converter.makeProtoServiceMessage(ctx, msg, source, deepFromId, e, handling.selfUser)
+		default:
+			continue
+		}
+
+		if err := save.MessageBatched(ctx, handling.converter, source, message, nil); err != nil {
+			logger.Message(gelf.LOG_CRIT, "telegram_backfill", "Message storage failed", logInfo, map[string]any{
+				"message_uid": message.MessageUid,
+				"err":         err.Error(),
+			})
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/go-faster/errors"
+	"github.com/gotd/contrib/storage"
+	"github.com/gotd/td/telegram/query"
+	"github.com/gotd/td/tg"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const dialogs_batch_size = 100
+
+// ListDialogs enumerates account dialogs with Telegram API.
+// Dialog peers are added to peer storage, so messages and history of listed sources can be handled.
+func (handling *telegramHandling) ListDialogs(ctx context.Context, api *tg.Client) ([]*proto.FlotgDialog, error) {
+	result := []*proto.FlotgDialog{}
+
+	iter := query.GetDialogs(api).BatchSize(dialogs_batch_size).Iter()
+
+	for iter.Next(ctx) {
+		elem := iter.Value()
+
+		var peer storage.Peer
+
+		switch p := elem.Dialog.GetPeer().(type) {
+		case *tg.PeerUser:
+			user, ok := elem.Entities.User(p.UserID)
+			if !ok || !peer.FromUser(user) {
+				continue
+			}
+		case *tg.PeerChat:
+			chat, ok := elem.Entities.Chat(p.ChatID)
+			if !ok || !peer.FromChat(chat) {
+				continue
+			}
+		case *tg.PeerChannel:
+			channel, ok := elem.Entities.Channel(p.ChannelID)
+			if !ok || !peer.FromChat(channel) {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if err := handling.peerDB.Add(ctx, peer); err != nil {
+			return nil, errors.Wrap(err, "add peer")
+		}
+
+		source, _ := handling.converter.makeProtoSource(nil, peer, tg.Entities{}, handling.selfUser)
+		if source.Flags == int32(proto.FLAGS_Invalid) {
+			continue
+		}
+
+		dialog := &proto.FlotgDialog{
+			Source: source,
+		}
+
+		if d, ok := elem.Dialog.(*tg.Dialog); ok {
+			dialog.UnreadCount = int32(d.UnreadCount)
+		}
+
+		if elem.Last != nil {
+			dialog.LastMessageAt = timestamppb.New(time.Unix(int64(elem.Last.GetDate()), 0))
+		}
+
+		result = append(result, dialog)
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, errors.Wrap(err, "iterate dialogs")
+	}
+
+	return result, nil
+}
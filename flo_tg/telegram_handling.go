@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
 
 	"github.com/go-faster/errors"
 	peeble "github.com/gotd/contrib/pebble"
@@ -13,24 +16,32 @@ import (
 )
 
 type telegramHandling struct {
-	bootstrap Bootstrap
-	peerDB    *peeble.PeerStorage
-	converter *converter
-	selfUser  *tg.User
+	bootstrap   Bootstrap
+	peerDB      *peeble.PeerStorage
+	converter   *converter
+	selfUser    *tg.User
+	attachments *attachmentDownloads
 }
 
 func newTelegramHandling(bootstrap Bootstrap, peerDB *peeble.PeerStorage, selfUser *tg.User) *telegramHandling {
 	return &telegramHandling{
-		bootstrap: bootstrap,
-		peerDB:    peerDB,
-		converter: newConverter(bootstrap),
-		selfUser:  selfUser,
+		bootstrap:   bootstrap,
+		peerDB:      peerDB,
+		converter:   newConverter(bootstrap, peerDB),
+		selfUser:    selfUser,
+		attachments: newAttachmentDownloads(bootstrap),
 	}
 }
 
 func (handling *telegramHandling) Attach(dispatcher tg.UpdateDispatcher) {
 	dispatcher.OnNewMessage(handling.handlerMessage())
 	dispatcher.OnNewChannelMessage(handling.handlerChannelMessage())
+	dispatcher.OnEditMessage(handling.handlerEditMessage())
+	dispatcher.OnEditChannelMessage(handling.handlerEditChannelMessage())
+	dispatcher.OnDeleteMessages(handling.handlerDeleteMessages())
+	dispatcher.OnDeleteChannelMessages(handling.handlerDeleteChannelMessages())
+	dispatcher.OnChannel(handling.handlerChannel())
+	dispatcher.OnUser(handling.handlerUser())
 }
 
 func (handling *telegramHandling) requestFromMessage(handler string, logInfo map[string]any, msg tg.MessageClass) (Logger, error) {
@@ -72,8 +83,11 @@ func (handling *telegramHandling) handlerMessage() tg.NewMessageHandler {
 			})
 			return nil
 
-		case *tg.MessageService: // TODO
-			// TODO Action : tg.MessageActionGroupCall
+		case *tg.MessageService:
+			logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Handling (service message) as genericHandleService", logInfo)
+			handling.bootstrap.Queue.Enqueue(func(ctx context.Context) {
+				handling.genericHandleService(handler, ctx, e, msg, logger)
+			})
 			return nil
 
 		default:
@@ -108,8 +122,11 @@ func (handling *telegramHandling) handlerChannelMessage() tg.NewChannelMessageHa
 			handling.bootstrap.Queue.Enqueue(op)
 			return nil
 
-		case *tg.MessageService: // TODO
-			// TODO Action : tg.MessageActionGroupCall
+		case *tg.MessageService:
+			logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Handling (service message) as genericHandleService", logInfo)
+			handling.bootstrap.Queue.Enqueue(func(ctx context.Context) {
+				handling.genericHandleService(handler, ctx, e, msg, logger)
+			})
 			return nil
 
 		default:
@@ -119,9 +136,237 @@ func (handling *telegramHandling) handlerChannelMessage() tg.NewChannelMessageHa
 	}
 }
 
+func (handling *telegramHandling) handlerEditMessage() tg.EditMessageHandler {
+	return func(ctx context.Context, e tg.Entities, u *tg.UpdateEditMessage) error {
+		handler := "handlerEditMessage"
+		logInfo := map[string]any{
+			"handler":              handler,
+			"entities":             e,
+			"debug_td_update_type": reflect.TypeOf(u).String(),
+		}
+
+		logger, err := handling.requestFromMessage(handler, logInfo, u.Message)
+		if err != nil {
+			return err
+		}
+
+		switch msg := u.Message.(type) {
+
+		case *tg.Message:
+			logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Handling (edit message) as genericHandleEdit", logInfo)
+			handling.bootstrap.Queue.Enqueue(func(ctx context.Context) {
+				handling.genericHandleEdit(handler, ctx, e, msg, logger)
+			})
+			return nil
+
+		case *tg.MessageService:
+			return nil
+
+		default:
+			logger.Message(gelf.LOG_WARNING, "telegram_handling", "Edit lost! Cast type failed (this should not happen, really)", logInfo)
+			return errors.New("message class type not implemented")
+		}
+	}
+}
+
+func (handling *telegramHandling) handlerEditChannelMessage() tg.EditChannelMessageHandler {
+	return func(ctx context.Context, e tg.Entities, u *tg.UpdateEditChannelMessage) error {
+		handler := "handlerEditChannelMessage"
+		logInfo := map[string]any{
+			"handler":              handler,
+			"entities":             e,
+			"debug_td_update_type": reflect.TypeOf(u).String(),
+		}
+
+		logger, err := handling.requestFromMessage(handler, logInfo, u.Message)
+		if err != nil {
+			return err
+		}
+
+		switch msg := u.Message.(type) {
+
+		case *tg.Message:
+			logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Handling (edit channel message) as genericHandleEdit", logInfo)
+			handling.bootstrap.Queue.Enqueue(func(ctx context.Context) {
+				handling.genericHandleEdit(handler, ctx, e, msg, logger)
+			})
+			return nil
+
+		case *tg.MessageService:
+			return nil
+
+		default:
+			logger.Message(gelf.LOG_WARNING, "telegram_handling", "Edit lost! Cast type failed (this should not happen, really)", logInfo)
+			return errors.New("message class type not implemented")
+		}
+	}
+}
+
+// Deleted messages of users and groups have no peer: message ids are unique per account, so ids are looked up in all user and group sources.
+func (handling *telegramHandling) handlerDeleteMessages() tg.DeleteMessagesHandler {
+	return func(ctx context.Context, e tg.Entities, u *tg.UpdateDeleteMessages) error {
+		handler := "handlerDeleteMessages"
+		logger := handling.bootstrap.Logger.AddRequestID(fmt.Sprintf("td-delete-%d-%s", u.Pts, RandStringBytesMaskImprSrcSB(8)))
+		deletedAt := time.Now().UTC()
+
+		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Handling (delete messages) as genericHandleDelete", map[string]any{
+			"handler":     handler,
+			"message_ids": u.Messages,
+		})
+
+		handling.bootstrap.Queue.Enqueue(func(ctx context.Context) {
+			handling.genericHandleDelete(handler, ctx, "", u.Messages, deletedAt, logger)
+		})
+
+		return nil
+	}
+}
+
+func (handling *telegramHandling) handlerDeleteChannelMessages() tg.DeleteChannelMessagesHandler {
+	return func(ctx context.Context, e tg.Entities, u *tg.UpdateDeleteChannelMessages) error {
+		handler := "handlerDeleteChannelMessages"
+		logger := handling.bootstrap.Logger.AddRequestID(fmt.Sprintf("td-delete-%d-%s", u.Pts, RandStringBytesMaskImprSrcSB(8)))
+		deletedAt := time.Now().UTC()
+		sourceUid := makeSourceUid(u.ChannelID)
+
+		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Handling (delete channel messages) as genericHandleDelete", map[string]any{
+			"handler":     handler,
+			"source_uid":  sourceUid,
+			"message_ids": u.Messages,
+		})
+
+		handling.bootstrap.Queue.Enqueue(func(ctx context.Context) {
+			handling.genericHandleDelete(handler, ctx, sourceUid, u.Messages, deletedAt, logger)
+		})
+
+		return nil
+	}
+}
+
+// Deleted messages are kept in storage and marked as deleted. Without source uid, messages of all user and group sources are marked.
+func (handling *telegramHandling) genericHandleDelete(handler string, ctx context.Context, sourceUid string, messageIDs []int, deletedAt time.Time, logger Logger) error {
+	save := handling.bootstrap.Storage.Saver(logger)
+
+	count, err := save.MessagesDeleted(ctx, sourceUid, messageIDs, deletedAt)
+	if err != nil {
+		logger.Message(gelf.LOG_CRIT, "telegram_handling", "Message deletion storage failed", map[string]any{
+			"handler":    handler,
+			"source_uid": sourceUid,
+			"err":        err.Error(),
+		})
+		return err
+	}
+
+	if count > 0 {
+		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Message deletion saved", map[string]any{
+			"handler":    handler,
+			"source_uid": sourceUid,
+			"count":      count,
+		})
+	}
+
+	return nil
+}
+
+// Channel is changed (title, username, verified, scam, ...), stored source is updated
+func (handling *telegramHandling) handlerChannel() tg.ChannelHandler {
+	return func(ctx context.Context, e tg.Entities, u *tg.UpdateChannel) error {
+		channel, ok := e.Channels[u.ChannelID]
+		if !ok || channel.Min {
+			return nil // update has no full channel object
+		}
+
+		var peer storage.Peer
+		if peer.FromChat(channel) {
+			handling.enqueueSourceSync("handlerChannel", peer)
+		}
+
+		return nil
+	}
+}
+
+// User is changed (name, username, premium, ...), stored source is updated
+func (handling *telegramHandling) handlerUser() tg.UserHandler {
+	return func(ctx context.Context, e tg.Entities, u *tg.UpdateUser) error {
+		user, ok := e.Users[u.UserID]
+		if !ok || user.Min {
+			return nil // update has no full user object
+		}
+
+		var peer storage.Peer
+		if peer.FromUser(user) {
+			handling.enqueueSourceSync("handlerUser", peer)
+		}
+
+		return nil
+	}
+}
+
+func (handling *telegramHandling) enqueueSourceSync(handler string, peer storage.Peer) {
+	source, _ := handling.converter.makeProtoSource(nil, peer, tg.Entities{}, handling.selfUser)
+	if source.Flags == int32(proto.FLAGS_Invalid) {
+		return
+	}
+
+	logger := handling.bootstrap.Logger.AddRequestID(fmt.Sprintf("td-source-%s-%s", source.SourceUid, RandStringBytesMaskImprSrcSB(8)))
+
+	logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Handling (source change) as genericHandleSourceSync", map[string]any{
+		"handler":    handler,
+		"source_uid": source.SourceUid,
+	})
+
+	handling.bootstrap.Queue.Enqueue(func(ctx context.Context) {
+		handling.genericHandleSourceSync(handler, ctx, source, logger)
+	})
+}
+
+// Changed source is saved only if it is stored already, changes do not add sources
+func (handling *telegramHandling) genericHandleSourceSync(handler string, ctx context.Context, source *proto.FLO_SOURCE, logger Logger) error {
+	logInfo := map[string]any{
+		"handler":    handler,
+		"source_uid": source.SourceUid,
+	}
+
+	read := handling.bootstrap.Storage.Reader(logger)
+
+	sources, err := read.Sources(ctx, sourcesQuery{Uids: []string{source.SourceUid}})
+	if err != nil {
+		logger.Message(gelf.LOG_ERR, "telegram_handling", "Source change lost! Sources read failed", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	if len(sources) == 0 {
+		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Source is not stored, change skipped", logInfo)
+		return nil
+	}
+
+	save := handling.bootstrap.Storage.Saver(logger)
+
+	if _, err := save.Source(ctx, handling.converter, source); err != nil {
+		logger.Message(gelf.LOG_ERR, "telegram_handling", "Source change storage failed", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
 func (handling *telegramHandling) genericHandleMessage(handler string, ctx context.Context, e tg.Entities, msg *tg.Message, logger Logger) error {
+	return handling.handleMessage(handler, ctx, e, msg, logger, false)
+}
+
+// Edited message is saved as a new revision of the stored message
+func (handling *telegramHandling) genericHandleEdit(handler string, ctx context.Context, e tg.Entities, msg *tg.Message, logger Logger) error {
+	return handling.handleMessage(handler, ctx, e, msg, logger, true)
+}
+
+func (handling *telegramHandling) handleMessage(handler string, ctx context.Context, e tg.Entities, msg *tg.Message, logger Logger, edit bool) error {
 
 	logInfo := map[string]any{
+		"edit":        edit,
 		"handler":     handler,
 		"entities":    e,
 		"from_id":     msg.FromID,
@@ -132,7 +377,7 @@ func (handling *telegramHandling) genericHandleMessage(handler string, ctx conte
 		"is_post":     msg.Post,
 	}
 
-	logger.Message(gelf.LOG_DEBUG, "telegram_handling", "genericHandleMessage", logInfo)
+	logger.Message(gelf.LOG_DEBUG, "telegram_handling", "handleMessage", logInfo)
 
 	peer, err := storage.FindPeer(ctx, handling.peerDB, msg.GetPeerID())
 	if err != nil {
@@ -150,21 +395,69 @@ func (handling *telegramHandling) genericHandleMessage(handler string, ctx conte
 		"debug_rpc": handling.converter.encodeToJson(source, false),
 	})
 
-	message := handling.converter.makeProtoMessage(msg, source, deepFromId)
+	message := handling.converter.makeProtoMessage(ctx, msg, source, deepFromId, e, handling.selfUser)
 
 	logger.Message(gelf.LOG_DEBUG, "telegram_handling", "After makeProtoMessage", logInfo, map[string]any{
 		"debug_rpc": handling.converter.encodeToJson(message, false),
 	})
 
-	logInfo["source_uid"] = source.SourceUid
-	logInfo["message_uid"] = message.MessageUid
 	logInfo["deepFromId"] = deepFromId
 
-	save := storageSave{
-		storage: handling.bootstrap.Storage,
-		logger:  logger,
+	// Attachment is downloaded once the message is saved, new messages may be saved later in a batch
+	return handling.storeMessage(ctx, source, message, edit, logger, logInfo, func() {
+		if _, download := handling.converter.makeProtoAttachment(msg); download != nil {
+			handling.attachments.Enqueue(download, logger)
+		}
+	})
+}
+
+// Service message (pin, title change, join, call, ...) is saved with messages of its source
+func (handling *telegramHandling) genericHandleService(handler string, ctx context.Context, e tg.Entities, msg *tg.MessageService, logger Logger) error {
+
+	logInfo := map[string]any{
+		"handler":    handler,
+		"entities":   e,
+		"from_id":    msg.FromID,
+		"peer_id":    msg.PeerID,
+		"message_id": msg.ID,
+		"action":     reflect.TypeOf(msg.Action).String(),
+	}
+
+	logger.Message(gelf.LOG_DEBUG, "telegram_handling", "genericHandleService", logInfo)
+
+	peer, err := storage.FindPeer(ctx, handling.peerDB, msg.GetPeerID())
+	if err != nil {
+
+		logger.Message(gelf.LOG_CRIT, "telegram_handling", "Service message lost! Peer not found in database", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+
+		return err
 	}
 
+	source, deepFromId := handling.converter.makeProtoSource(nil, peer, e, handling.selfUser)
+
+	message := handling.converter.makeProtoServiceMessage(ctx, msg, source, deepFromId, e, handling.selfUser)
+
+	logger.Message(gelf.LOG_DEBUG, "telegram_handling", "After makeProtoServiceMessage", logInfo, map[string]any{
+		"debug_rpc": handling.converter.encodeToJson(message, false),
+	})
+
+	return handling.storeMessage(ctx, source, message, false, logger, logInfo, nil)
+}
+
+// Save source and message of a monitored source, and publish saved message to Feed.
+// New message may be saved later in a batch, [saved] (optional) is called after it is saved and published.
+func (handling *telegramHandling) storeMessage(ctx context.Context, source *proto.FLO_SOURCE, message *proto.FLO_MESSAGE, edit bool, logger Logger, logInfo map[string]any, saved func()) error {
+
+	logInfo["source_uid"] = source.SourceUid
+	logInfo["message_uid"] = message.MessageUid
+
+	save := handling.bootstrap.Storage.Saver(logger)
+
+	// Source is saved even if not monitored, so it can be found and turned on
+	source.Monitored = handling.bootstrap.MonitorNewSources
+
 	sourceRefId, err := save.Source(ctx, handling.converter, source)
 	logInfo["sourceRefId"] = sourceRefId
 
@@ -177,16 +470,51 @@ func (handling *telegramHandling) genericHandleMessage(handler string, ctx conte
 		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Source saved", logInfo)
 	}
 
-	messageRefId, err := save.Message(ctx, handling.converter, source, message)
+	read := handling.bootstrap.Storage.Reader(logger)
+
+	monitored, err := read.SourceMonitored(ctx, source.SourceUid)
+	if err != nil {
+		logger.Message(gelf.LOG_CRIT, "telegram_handling", "Message lost! Source monitoring state read failed", logInfo, map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	if !monitored {
+		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Source is not monitored, message skipped", logInfo)
+		return nil
+	}
+
+	published := func() {
+		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Message saved", logInfo)
+		handling.bootstrap.Feed.Publish(message)
+
+		if saved != nil {
+			saved()
+		}
+	}
+
+	if !edit {
+		err = save.MessageBatched(ctx, handling.converter, source, message, published)
+		if err != nil {
+			logger.Message(gelf.LOG_CRIT, "telegram_handling", "Message storage failed", logInfo, map[string]any{
+				"err": err.Error(),
+			})
+		}
+		return err
+	}
+
+	messageRefId, err := save.MessageEdit(ctx, handling.converter, source, message)
 	logInfo["message_ref_id"] = messageRefId
 
 	if err != nil {
 		logger.Message(gelf.LOG_CRIT, "telegram_handling", "Message storage failed", logInfo, map[string]any{
 			"err": err.Error(),
 		})
-	} else {
-		logger.Message(gelf.LOG_DEBUG, "telegram_handling", "Message saved", logInfo)
+		return err
 	}
 
-	return err
+	published()
+
+	return nil
 }
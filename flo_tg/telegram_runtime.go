@@ -0,0 +1,62 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/gotd/td/tg"
+)
+
+// TelegramRuntime shares the running telegram client with RPC handlers.
+// It is empty before the client is authorized and listening for updates, and after it is stopped.
+type TelegramRuntime struct {
+	mu       sync.RWMutex
+	api      *tg.Client
+	handling *telegramHandling
+	locks    map[string]bool
+}
+
+func NewTelegramRuntime() *TelegramRuntime {
+	return &TelegramRuntime{
+		locks: map[string]bool{},
+	}
+}
+
+func (rt *TelegramRuntime) set(api *tg.Client, handling *telegramHandling) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.api = api
+	rt.handling = handling
+}
+
+func (rt *TelegramRuntime) clear() {
+	rt.set(nil, nil)
+}
+
+// Running client API and updates handling, ok is false when telegram client is not running
+func (rt *TelegramRuntime) Get() (api *tg.Client, handling *telegramHandling, ok bool) {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	return rt.api, rt.handling, rt.api != nil && rt.handling != nil
+}
+
+// Lock named long-running task (e.g. backfill of a source), so it is not started twice.
+// Returns false if task is already running, otherwise unlock must be called when task is done.
+func (rt *TelegramRuntime) Lock(key string) (unlock func(), ok bool) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if rt.locks[key] {
+		return nil, false
+	}
+
+	rt.locks[key] = true
+
+	return func() {
+		rt.mu.Lock()
+		defer rt.mu.Unlock()
+
+		delete(rt.locks, key)
+	}, true
+}
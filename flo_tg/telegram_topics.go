@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+	"github.com/go-faster/errors"
+	"github.com/gotd/contrib/storage"
+	"github.com/gotd/td/tg"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const topics_page_size = 100
+
+// ListTopics enumerates forum topics of a supergroup with Telegram API, pinned topics first, then by last message.
+func (handling *telegramHandling) ListTopics(ctx context.Context, api *tg.Client, peer storage.Peer, sourceUid string) ([]*proto.FlotgTopic, error) {
+	if peer.Channel == nil || !peer.Channel.Forum {
+		return nil, errors.New("not a forum")
+	}
+
+	result := []*proto.FlotgTopic{}
+
+	request := &tg.ChannelsGetForumTopicsRequest{
+		Channel: peer.Channel.AsInput(),
+		Limit:   topics_page_size,
+	}
+
+	for {
+		res, err := api.ChannelsGetForumTopics(ctx, request)
+		if err != nil {
+			return nil, errors.Wrap(err, "get forum topics")
+		}
+
+		handling.collectEntities(ctx, res.Users, res.Chats)
+
+		var last *tg.ForumTopic
+
+		for _, t := range res.Topics {
+			topic, ok := t.(*tg.ForumTopic)
+			if !ok {
+				continue // deleted topic
+			}
+
+			result = append(result, &proto.FlotgTopic{
+				SourceUid:     sourceUid,
+				TopicId:       int32(topic.ID),
+				Title:         topic.Title,
+				CreatedAt:     timestamppb.New(time.Unix(int64(topic.Date), 0)),
+				Closed:        topic.Closed,
+				Pinned:        topic.Pinned,
+				Hidden:        topic.Hidden,
+				TopMessageUid: makeMessageUid(sourceUid, topic.TopMessage),
+			})
+
+			last = topic
+		}
+
+		if last == nil || len(res.Topics) < topics_page_size || len(result) >= res.Count {
+			break
+		}
+
+		// Next page is after the last topic, by its last message
+		request.OffsetTopic = last.ID
+		request.OffsetID = last.TopMessage
+		request.OffsetDate = 0
+		for _, m := range res.Messages {
+			if m.GetID() == last.TopMessage {
+				if msg, ok := m.(interface{ GetDate() int }); ok {
+					request.OffsetDate = msg.GetDate()
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+)
+
+// Message text is rendered with formatting entities as Markdown or as HTML.
+// Entity offsets are in UTF-16 code units, so text is split in UTF-16 and decoded back for each part.
+
+// Render text with entities as Markdown (CommonMark with strikethrough)
+func renderMarkdown(text string, entities []*proto.FLO_ENTITY) string {
+	return renderText(markdownFormat{}, text, entities)
+}
+
+// Render text with entities as HTML. Text is escaped, only links with safe schemes are kept.
+func renderHTML(text string, entities []*proto.FLO_ENTITY) string {
+	return renderText(htmlFormat{}, text, entities)
+}
+
+type textFormat interface {
+	// Plain text part, code is true inside code and pre entities
+	text(s string, code bool) string
+
+	// Entity with rendered inner text, raw is the entity text as is
+	entity(e *proto.FLO_ENTITY, inner, raw string) string
+}
+
+type entityNode struct {
+	entity     *proto.FLO_ENTITY
+	start, end int // UTF-16 code units
+	children   []*entityNode
+}
+
+func renderText(f textFormat, text string, entities []*proto.FLO_ENTITY) string {
+	u := utf16.Encode([]rune(text))
+	return renderNodes(f, u, 0, len(u), entityTree(entities, u))
+}
+
+func renderNodes(f textFormat, u []uint16, start, end int, nodes []*entityNode) string {
+	var b strings.Builder
+
+	pos := start
+	for _, n := range nodes {
+		b.WriteString(f.text(decodeUTF16(u[pos:n.start]), false))
+
+		raw := decodeUTF16(u[n.start:n.end])
+
+		// No formatting inside code
+		var inner string
+		if isCodeEntity(n.entity) {
+			inner = f.text(raw, true)
+		} else {
+			inner = renderNodes(f, u, n.start, n.end, n.children)
+		}
+
+		b.WriteString(f.entity(n.entity, inner, raw))
+		pos = n.end
+	}
+	b.WriteString(f.text(decodeUTF16(u[pos:end]), false))
+
+	return b.String()
+}
+
+// Entities nested by position. Entity partially overlapping an outer entity is split at the end of outer one.
+// Entities out of text bounds are clamped or skipped, bounds inside a surrogate pair are moved to include the pair.
+func entityTree(entities []*proto.FLO_ENTITY, u []uint16) []*entityNode {
+	textLen := len(u)
+
+	pending := []*entityNode{}
+
+	for _, e := range entities {
+		start, end := int(e.Offset), int(e.Offset)+int(e.Length)
+		if start < 0 || e.Length <= 0 || start >= textLen {
+			continue
+		}
+		if end > textLen {
+			end = textLen
+		}
+		if insideSurrogatePair(u, start) {
+			start--
+		}
+		if insideSurrogatePair(u, end) {
+			end++
+		}
+		pending = append(pending, &entityNode{entity: e, start: start, end: end})
+	}
+
+	// Outer entity (longer one) goes first when entities start at the same position
+	less := func(a, b *entityNode) bool {
+		if a.start != b.start {
+			return a.start < b.start
+		}
+		return a.end > b.end
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return less(pending[i], pending[j])
+	})
+
+	root := &entityNode{start: 0, end: textLen}
+	stack := []*entityNode{root}
+
+	for len(pending) > 0 {
+		node := pending[0]
+		pending = pending[1:]
+
+		for node.start >= stack[len(stack)-1].end {
+			stack = stack[:len(stack)-1]
+		}
+
+		parent := stack[len(stack)-1]
+		if node.end > parent.end {
+			// Remainder goes after pending entities it is not before, as with stable sort
+			rest := &entityNode{entity: node.entity, start: parent.end, end: node.end}
+			at := sort.Search(len(pending), func(i int) bool {
+				return less(rest, pending[i])
+			})
+			pending = append(pending[:at], append([]*entityNode{rest}, pending[at:]...)...)
+
+			node.end = parent.end
+		}
+
+		parent.children = append(parent.children, node)
+		stack = append(stack, node)
+	}
+
+	return root.children
+}
+
+// Position is between high and low surrogates of a character
+func insideSurrogatePair(u []uint16, pos int) bool {
+	return pos > 0 && pos < len(u) && u[pos-1] >= 0xd800 && u[pos-1] < 0xdc00 && u[pos] >= 0xdc00 && u[pos] < 0xe000
+}
+
+func decodeUTF16(u []uint16) string {
+	return string(utf16.Decode(u))
+}
+
+func isCodeEntity(e *proto.FLO_ENTITY) bool {
+	return e.Type == proto.FLO_ENTITY_TYPE_EntityCode || e.Type == proto.FLO_ENTITY_TYPE_EntityPre
+}
+
+// Link target of entity, empty if entity is not a link or link scheme is not safe
+func entityLinkURL(e *proto.FLO_ENTITY, raw string) string {
+	switch e.Type {
+	case proto.FLO_ENTITY_TYPE_EntityTextUrl:
+		return safeLinkURL(e.Url)
+	case proto.FLO_ENTITY_TYPE_EntityUrl:
+		if !strings.Contains(raw, "://") {
+			raw = "http://" + raw
+		}
+		return safeLinkURL(raw)
+	case proto.FLO_ENTITY_TYPE_EntityEmail:
+		return safeLinkURL("mailto:" + raw)
+	case proto.FLO_ENTITY_TYPE_EntityMention:
+		return "https://t.me/" + url.PathEscape(strings.TrimPrefix(raw, "@"))
+	case proto.FLO_ENTITY_TYPE_EntityMentionName:
+		return fmt.Sprintf("tg://user?id=%d", e.UserId)
+	}
+	return ""
+}
+
+func safeLinkURL(link string) string {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return ""
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto", "tg":
+		return u.String()
+	}
+
+	return ""
+}
+
+// Language of code block, as used in class name or code fence
+func codeLanguage(language string) string {
+	return strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_+#.-", r)) {
+			return r
+		}
+		return -1
+	}, language)
+}
+
+type markdownFormat struct{}
+
+var (
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "~", `\~`, "[", `\[`, "]", `\]`,
+		"(", `\(`, ")", `\)`, "<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`,
+	)
+	markdownURLEscaper = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E")
+
+	// List markers at line start ("#" is escaped everywhere). Text part may start a line, escaping mid-line is harmless.
+	markdownBulletStart  = regexp.MustCompile(`(?m)^([ \t]*)([-+])`)
+	markdownOrderedStart = regexp.MustCompile(`(?m)^([ \t]*)(\d+)\.`)
+)
+
+func (markdownFormat) text(s string, code bool) string {
+	if code {
+		return s
+	}
+	s = markdownEscaper.Replace(s)
+	s = markdownBulletStart.ReplaceAllString(s, `${1}\${2}`)
+	return markdownOrderedStart.ReplaceAllString(s, `${1}${2}\.`)
+}
+
+func (markdownFormat) entity(e *proto.FLO_ENTITY, inner, raw string) string {
+	if link := entityLinkURL(e, raw); link != "" {
+		return "[" + inner + "](" + markdownURLEscaper.Replace(link) + ")"
+	}
+
+	switch e.Type {
+	case proto.FLO_ENTITY_TYPE_EntityBold:
+		return markdownEmphasis("**", inner)
+	case proto.FLO_ENTITY_TYPE_EntityItalic:
+		return markdownEmphasis("_", inner)
+	case proto.FLO_ENTITY_TYPE_EntityStrike:
+		return markdownEmphasis("~~", inner)
+	case proto.FLO_ENTITY_TYPE_EntityCode:
+		fence := "`"
+		for strings.Contains(raw, fence) {
+			fence += "`"
+		}
+		if strings.HasPrefix(raw, "`") || strings.HasSuffix(raw, "`") {
+			raw = " " + raw + " "
+		}
+		return fence + raw + fence
+	case proto.FLO_ENTITY_TYPE_EntityPre:
+		fence := "```"
+		for strings.Contains(raw, fence) {
+			fence += "`"
+		}
+		return fence + codeLanguage(e.Language) + "\n" + strings.TrimSuffix(raw, "\n") + "\n" + fence
+	case proto.FLO_ENTITY_TYPE_EntityBlockquote:
+		quote := strings.TrimSuffix(inner, "\n")
+		return "> " + strings.ReplaceAll(quote, "\n", "\n> ") + inner[len(quote):]
+	}
+
+	// Underline, spoiler and others have no Markdown form
+	return inner
+}
+
+// Emphasis markers must be next to non-space text, spaces are moved out
+func markdownEmphasis(marker, inner string) string {
+	core := strings.TrimLeftFunc(inner, unicode.IsSpace)
+	lead := inner[:len(inner)-len(core)]
+	core = strings.TrimRightFunc(core, unicode.IsSpace)
+	trail := inner[len(lead)+len(core):]
+
+	if core == "" {
+		return inner
+	}
+
+	return lead + marker + core + marker + trail
+}
+
+type htmlFormat struct{}
+
+func (htmlFormat) text(s string, code bool) string {
+	s = html.EscapeString(s)
+	if code {
+		return s
+	}
+	return strings.ReplaceAll(s, "\n", "<br>\n")
+}
+
+func (htmlFormat) entity(e *proto.FLO_ENTITY, inner, raw string) string {
+	if link := entityLinkURL(e, raw); link != "" {
+		return `<a href="` + html.EscapeString(link) + `">` + inner + "</a>"
+	}
+
+	switch e.Type {
+	case proto.FLO_ENTITY_TYPE_EntityBold:
+		return "<b>" + inner + "</b>"
+	case proto.FLO_ENTITY_TYPE_EntityItalic:
+		return "<i>" + inner + "</i>"
+	case proto.FLO_ENTITY_TYPE_EntityUnderline:
+		return "<u>" + inner + "</u>"
+	case proto.FLO_ENTITY_TYPE_EntityStrike:
+		return "<s>" + inner + "</s>"
+	case proto.FLO_ENTITY_TYPE_EntitySpoiler:
+		return `<span class="tg-spoiler">` + inner + "</span>"
+	case proto.FLO_ENTITY_TYPE_EntityCode:
+		return "<code>" + inner + "</code>"
+	case proto.FLO_ENTITY_TYPE_EntityPre:
+		if language := codeLanguage(e.Language); language != "" {
+			return `<pre><code class="language-` + language + `">` + inner + "</code></pre>"
+		}
+		return "<pre><code>" + inner + "</code></pre>"
+	case proto.FLO_ENTITY_TYPE_EntityBlockquote:
+		return "<blockquote>" + inner + "</blockquote>"
+	}
+
+	return inner
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/flogram-lab/wayout/flo_tg/proto"
+)
+
+func testEntity(entityType proto.FLO_ENTITY_TYPE, offset, length int32) *proto.FLO_ENTITY {
+	return &proto.FLO_ENTITY{
+		Type:   entityType,
+		Offset: offset,
+		Length: length,
+	}
+}
+
+func testLinkEntity(link string, offset, length int32) *proto.FLO_ENTITY {
+	e := testEntity(proto.FLO_ENTITY_TYPE_EntityTextUrl, offset, length)
+	e.Url = link
+	return e
+}
+
+func TestRenderText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		entities []*proto.FLO_ENTITY
+		markdown string
+		html     string
+	}{
+		{
+			name:     "plain text is escaped",
+			text:     "a*b <c>",
+			markdown: `a\*b \<c\>`,
+			html:     "a*b &lt;c&gt;",
+		},
+		{
+			// Emoji is two UTF-16 code units
+			name:     "emoji before entity",
+			text:     "😀 bold",
+			entities: []*proto.FLO_ENTITY{testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 3, 4)},
+			markdown: "😀 **bold**",
+			html:     "😀 <b>bold</b>",
+		},
+		{
+			name:     "offset inside surrogate pair",
+			text:     "a😀b",
+			entities: []*proto.FLO_ENTITY{testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 2, 1)},
+			markdown: "a**😀**b",
+			html:     "a<b>😀</b>b",
+		},
+		{
+			name:     "end inside surrogate pair",
+			text:     "a😀b",
+			entities: []*proto.FLO_ENTITY{testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 0, 2)},
+			markdown: "**a😀**b",
+			html:     "<b>a😀</b>b",
+		},
+		{
+			name: "nested entities",
+			text: "bold italic",
+			entities: []*proto.FLO_ENTITY{
+				testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 0, 11),
+				testEntity(proto.FLO_ENTITY_TYPE_EntityItalic, 5, 6),
+			},
+			markdown: "**bold _italic_**",
+			html:     "<b>bold <i>italic</i></b>",
+		},
+		{
+			name: "nested entities, inner one first",
+			text: "bold italic",
+			entities: []*proto.FLO_ENTITY{
+				testEntity(proto.FLO_ENTITY_TYPE_EntityItalic, 0, 4),
+				testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 0, 11),
+			},
+			markdown: "**_bold_ italic**",
+			html:     "<b><i>bold</i> italic</b>",
+		},
+		{
+			name: "overlapping entities",
+			text: "abcdef",
+			entities: []*proto.FLO_ENTITY{
+				testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 0, 4),
+				testEntity(proto.FLO_ENTITY_TYPE_EntityItalic, 2, 4),
+			},
+			markdown: "**ab_cd_**_ef_",
+			html:     "<b>ab<i>cd</i></b><i>ef</i>",
+		},
+		{
+			name: "out of range entities",
+			text: "abc",
+			entities: []*proto.FLO_ENTITY{
+				testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 1, 10),
+				testEntity(proto.FLO_ENTITY_TYPE_EntityItalic, 5, 1),
+				testEntity(proto.FLO_ENTITY_TYPE_EntityItalic, -1, 2),
+				testEntity(proto.FLO_ENTITY_TYPE_EntityItalic, 0, 0),
+			},
+			markdown: "a**bc**",
+			html:     "a<b>bc</b>",
+		},
+		{
+			name:     "code with backtick",
+			text:     "x a`b y",
+			entities: []*proto.FLO_ENTITY{testEntity(proto.FLO_ENTITY_TYPE_EntityCode, 2, 3)},
+			markdown: "x ``a`b`` y",
+			html:     "x <code>a`b</code> y",
+		},
+		{
+			name:     "code starting with backtick",
+			text:     "`a",
+			entities: []*proto.FLO_ENTITY{testEntity(proto.FLO_ENTITY_TYPE_EntityCode, 0, 2)},
+			markdown: "`` `a ``",
+			html:     "<code>`a</code>",
+		},
+		{
+			name: "no formatting inside code",
+			text: "a*b<c>",
+			entities: []*proto.FLO_ENTITY{
+				testEntity(proto.FLO_ENTITY_TYPE_EntityCode, 0, 6),
+				testEntity(proto.FLO_ENTITY_TYPE_EntityBold, 0, 1),
+			},
+			markdown: "`a*b<c>`",
+			html:     "<code>a*b&lt;c&gt;</code>",
+		},
+		{
+			name:     "link",
+			text:     "click",
+			entities: []*proto.FLO_ENTITY{testLinkEntity("https://example.com/a b", 0, 5)},
+			markdown: "[click](https://example.com/a%20b)",
+			html:     `<a href="https://example.com/a%20b">click</a>`,
+		},
+		{
+			name:     "javascript link is dropped",
+			text:     "click",
+			entities: []*proto.FLO_ENTITY{testLinkEntity("javascript:alert(1)", 0, 5)},
+			markdown: "click",
+			html:     "click",
+		},
+		{
+			name:     "javascript link with other case and spaces is dropped",
+			text:     "click",
+			entities: []*proto.FLO_ENTITY{testLinkEntity(" JavaScript:alert(1)", 0, 5)},
+			markdown: "click",
+			html:     "click",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderMarkdown(tt.text, tt.entities); got != tt.markdown {
+				t.Errorf("renderMarkdown() = %q, expected %q", got, tt.markdown)
+			}
+			if got := renderHTML(tt.text, tt.entities); got != tt.html {
+				t.Errorf("renderHTML() = %q, expected %q", got, tt.html)
+			}
+		})
+	}
+}
@@ -19,7 +19,7 @@ public protocol FlotgServiceClientProtocol: GRPCClient {
   func ready(
     _ request: SwiftProtobuf.Google_Protobuf_Empty,
     callOptions: CallOptions?
-  ) -> UnaryCall<SwiftProtobuf.Google_Protobuf_Empty, SwiftProtobuf.Google_Protobuf_Empty>
+  ) -> UnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgReadyResponse>
 
   func getSources(
     _ request: FlotgGetSourcesRequest,
@@ -32,6 +32,78 @@ public protocol FlotgServiceClientProtocol: GRPCClient {
     callOptions: CallOptions?,
     handler: @escaping (FLO_MESSAGE) -> Void
   ) -> ServerStreamingCall<FlotgGetMessagesRequest, FLO_MESSAGE>
+
+  func streamMessages(
+    _ request: FlotgStreamMessagesRequest,
+    callOptions: CallOptions?,
+    handler: @escaping (FLO_MESSAGE) -> Void
+  ) -> ServerStreamingCall<FlotgStreamMessagesRequest, FLO_MESSAGE>
+
+  func enableMonitoring(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions?
+  ) -> UnaryCall<FlotgSourceRequest, FLO_SOURCE>
+
+  func disableMonitoring(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions?
+  ) -> UnaryCall<FlotgSourceRequest, FLO_SOURCE>
+
+  func getMonitoredSources(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions?,
+    handler: @escaping (FLO_SOURCE) -> Void
+  ) -> ServerStreamingCall<SwiftProtobuf.Google_Protobuf_Empty, FLO_SOURCE>
+
+  func backfillSource(
+    _ request: FlotgBackfillRequest,
+    callOptions: CallOptions?,
+    handler: @escaping (FlotgBackfillProgress) -> Void
+  ) -> ServerStreamingCall<FlotgBackfillRequest, FlotgBackfillProgress>
+
+  func listDialogs(
+    _ request: FlotgListDialogsRequest,
+    callOptions: CallOptions?,
+    handler: @escaping (FlotgDialog) -> Void
+  ) -> ServerStreamingCall<FlotgListDialogsRequest, FlotgDialog>
+
+  func getAttachment(
+    _ request: FlotgAttachmentRequest,
+    callOptions: CallOptions?,
+    handler: @escaping (FlotgAttachmentChunk) -> Void
+  ) -> ServerStreamingCall<FlotgAttachmentRequest, FlotgAttachmentChunk>
+
+  func getThread(
+    _ request: FlotgThreadRequest,
+    callOptions: CallOptions?,
+    handler: @escaping (FLO_MESSAGE) -> Void
+  ) -> ServerStreamingCall<FlotgThreadRequest, FLO_MESSAGE>
+
+  func listTopics(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions?,
+    handler: @escaping (FlotgTopic) -> Void
+  ) -> ServerStreamingCall<FlotgSourceRequest, FlotgTopic>
+
+  func authBegin(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions?
+  ) -> UnaryCall<FlotgAuthRequest, FlotgAuthStatus>
+
+  func authSubmitCode(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions?
+  ) -> UnaryCall<FlotgAuthRequest, FlotgAuthStatus>
+
+  func authSubmitPassword(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions?
+  ) -> UnaryCall<FlotgAuthRequest, FlotgAuthStatus>
+
+  func authGetStatus(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions?
+  ) -> UnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgAuthStatus>
 }
 
 extension FlotgServiceClientProtocol {
@@ -48,7 +120,7 @@ extension FlotgServiceClientProtocol {
   public func ready(
     _ request: SwiftProtobuf.Google_Protobuf_Empty,
     callOptions: CallOptions? = nil
-  ) -> UnaryCall<SwiftProtobuf.Google_Protobuf_Empty, SwiftProtobuf.Google_Protobuf_Empty> {
+  ) -> UnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgReadyResponse> {
     return self.makeUnaryCall(
       path: FlotgServiceClientMetadata.Methods.ready.path,
       request: request,
@@ -98,6 +170,261 @@ extension FlotgServiceClientProtocol {
       handler: handler
     )
   }
+
+  /// Server streaming call to StreamMessages
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to StreamMessages.
+  ///   - callOptions: Call options.
+  ///   - handler: A closure called when each response is received from the server.
+  /// - Returns: A `ServerStreamingCall` with futures for the metadata and status.
+  public func streamMessages(
+    _ request: FlotgStreamMessagesRequest,
+    callOptions: CallOptions? = nil,
+    handler: @escaping (FLO_MESSAGE) -> Void
+  ) -> ServerStreamingCall<FlotgStreamMessagesRequest, FLO_MESSAGE> {
+    return self.makeServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.streamMessages.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeStreamMessagesInterceptors() ?? [],
+      handler: handler
+    )
+  }
+
+  /// Unary call to EnableMonitoring
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to EnableMonitoring.
+  ///   - callOptions: Call options.
+  /// - Returns: A `UnaryCall` with futures for the metadata, status and response.
+  public func enableMonitoring(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) -> UnaryCall<FlotgSourceRequest, FLO_SOURCE> {
+    return self.makeUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.enableMonitoring.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeEnableMonitoringInterceptors() ?? []
+    )
+  }
+
+  /// Unary call to DisableMonitoring
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to DisableMonitoring.
+  ///   - callOptions: Call options.
+  /// - Returns: A `UnaryCall` with futures for the metadata, status and response.
+  public func disableMonitoring(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) -> UnaryCall<FlotgSourceRequest, FLO_SOURCE> {
+    return self.makeUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.disableMonitoring.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeDisableMonitoringInterceptors() ?? []
+    )
+  }
+
+  /// Server streaming call to GetMonitoredSources
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to GetMonitoredSources.
+  ///   - callOptions: Call options.
+  ///   - handler: A closure called when each response is received from the server.
+  /// - Returns: A `ServerStreamingCall` with futures for the metadata and status.
+  public func getMonitoredSources(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions? = nil,
+    handler: @escaping (FLO_SOURCE) -> Void
+  ) -> ServerStreamingCall<SwiftProtobuf.Google_Protobuf_Empty, FLO_SOURCE> {
+    return self.makeServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getMonitoredSources.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetMonitoredSourcesInterceptors() ?? [],
+      handler: handler
+    )
+  }
+
+  /// Server streaming call to BackfillSource
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to BackfillSource.
+  ///   - callOptions: Call options.
+  ///   - handler: A closure called when each response is received from the server.
+  /// - Returns: A `ServerStreamingCall` with futures for the metadata and status.
+  public func backfillSource(
+    _ request: FlotgBackfillRequest,
+    callOptions: CallOptions? = nil,
+    handler: @escaping (FlotgBackfillProgress) -> Void
+  ) -> ServerStreamingCall<FlotgBackfillRequest, FlotgBackfillProgress> {
+    return self.makeServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.backfillSource.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeBackfillSourceInterceptors() ?? [],
+      handler: handler
+    )
+  }
+
+  /// Server streaming call to ListDialogs
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to ListDialogs.
+  ///   - callOptions: Call options.
+  ///   - handler: A closure called when each response is received from the server.
+  /// - Returns: A `ServerStreamingCall` with futures for the metadata and status.
+  public func listDialogs(
+    _ request: FlotgListDialogsRequest,
+    callOptions: CallOptions? = nil,
+    handler: @escaping (FlotgDialog) -> Void
+  ) -> ServerStreamingCall<FlotgListDialogsRequest, FlotgDialog> {
+    return self.makeServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.listDialogs.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeListDialogsInterceptors() ?? [],
+      handler: handler
+    )
+  }
+
+  /// Server streaming call to GetAttachment
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to GetAttachment.
+  ///   - callOptions: Call options.
+  ///   - handler: A closure called when each response is received from the server.
+  /// - Returns: A `ServerStreamingCall` with futures for the metadata and status.
+  public func getAttachment(
+    _ request: FlotgAttachmentRequest,
+    callOptions: CallOptions? = nil,
+    handler: @escaping (FlotgAttachmentChunk) -> Void
+  ) -> ServerStreamingCall<FlotgAttachmentRequest, FlotgAttachmentChunk> {
+    return self.makeServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getAttachment.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetAttachmentInterceptors() ?? [],
+      handler: handler
+    )
+  }
+
+  /// Server streaming call to GetThread
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to GetThread.
+  ///   - callOptions: Call options.
+  ///   - handler: A closure called when each response is received from the server.
+  /// - Returns: A `ServerStreamingCall` with futures for the metadata and status.
+  public func getThread(
+    _ request: FlotgThreadRequest,
+    callOptions: CallOptions? = nil,
+    handler: @escaping (FLO_MESSAGE) -> Void
+  ) -> ServerStreamingCall<FlotgThreadRequest, FLO_MESSAGE> {
+    return self.makeServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getThread.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetThreadInterceptors() ?? [],
+      handler: handler
+    )
+  }
+
+  /// Server streaming call to ListTopics
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to ListTopics.
+  ///   - callOptions: Call options.
+  ///   - handler: A closure called when each response is received from the server.
+  /// - Returns: A `ServerStreamingCall` with futures for the metadata and status.
+  public func listTopics(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil,
+    handler: @escaping (FlotgTopic) -> Void
+  ) -> ServerStreamingCall<FlotgSourceRequest, FlotgTopic> {
+    return self.makeServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.listTopics.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeListTopicsInterceptors() ?? [],
+      handler: handler
+    )
+  }
+
+  /// Telegram login, when flo_tg runs with TG_AUTH=rpc
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to AuthBegin.
+  ///   - callOptions: Call options.
+  /// - Returns: A `UnaryCall` with futures for the metadata, status and response.
+  public func authBegin(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) -> UnaryCall<FlotgAuthRequest, FlotgAuthStatus> {
+    return self.makeUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authBegin.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthBeginInterceptors() ?? []
+    )
+  }
+
+  /// Unary call to AuthSubmitCode
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to AuthSubmitCode.
+  ///   - callOptions: Call options.
+  /// - Returns: A `UnaryCall` with futures for the metadata, status and response.
+  public func authSubmitCode(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) -> UnaryCall<FlotgAuthRequest, FlotgAuthStatus> {
+    return self.makeUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authSubmitCode.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthSubmitCodeInterceptors() ?? []
+    )
+  }
+
+  /// Unary call to AuthSubmitPassword
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to AuthSubmitPassword.
+  ///   - callOptions: Call options.
+  /// - Returns: A `UnaryCall` with futures for the metadata, status and response.
+  public func authSubmitPassword(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) -> UnaryCall<FlotgAuthRequest, FlotgAuthStatus> {
+    return self.makeUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authSubmitPassword.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthSubmitPasswordInterceptors() ?? []
+    )
+  }
+
+  /// Unary call to AuthGetStatus
+  ///
+  /// - Parameters:
+  ///   - request: Request to send to AuthGetStatus.
+  ///   - callOptions: Call options.
+  /// - Returns: A `UnaryCall` with futures for the metadata, status and response.
+  public func authGetStatus(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions? = nil
+  ) -> UnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgAuthStatus> {
+    return self.makeUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authGetStatus.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthGetStatusInterceptors() ?? []
+    )
+  }
 }
 
 @available(*, deprecated)
@@ -165,7 +492,7 @@ public protocol FlotgServiceAsyncClientProtocol: GRPCClient {
   func makeReadyCall(
     _ request: SwiftProtobuf.Google_Protobuf_Empty,
     callOptions: CallOptions?
-  ) -> GRPCAsyncUnaryCall<SwiftProtobuf.Google_Protobuf_Empty, SwiftProtobuf.Google_Protobuf_Empty>
+  ) -> GRPCAsyncUnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgReadyResponse>
 
   func makeGetSourcesCall(
     _ request: FlotgGetSourcesRequest,
@@ -176,6 +503,71 @@ public protocol FlotgServiceAsyncClientProtocol: GRPCClient {
     _ request: FlotgGetMessagesRequest,
     callOptions: CallOptions?
   ) -> GRPCAsyncServerStreamingCall<FlotgGetMessagesRequest, FLO_MESSAGE>
+
+  func makeStreamMessagesCall(
+    _ request: FlotgStreamMessagesRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncServerStreamingCall<FlotgStreamMessagesRequest, FLO_MESSAGE>
+
+  func makeEnableMonitoringCall(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncUnaryCall<FlotgSourceRequest, FLO_SOURCE>
+
+  func makeDisableMonitoringCall(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncUnaryCall<FlotgSourceRequest, FLO_SOURCE>
+
+  func makeGetMonitoredSourcesCall(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncServerStreamingCall<SwiftProtobuf.Google_Protobuf_Empty, FLO_SOURCE>
+
+  func makeBackfillSourceCall(
+    _ request: FlotgBackfillRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncServerStreamingCall<FlotgBackfillRequest, FlotgBackfillProgress>
+
+  func makeListDialogsCall(
+    _ request: FlotgListDialogsRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncServerStreamingCall<FlotgListDialogsRequest, FlotgDialog>
+
+  func makeGetAttachmentCall(
+    _ request: FlotgAttachmentRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncServerStreamingCall<FlotgAttachmentRequest, FlotgAttachmentChunk>
+
+  func makeGetThreadCall(
+    _ request: FlotgThreadRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncServerStreamingCall<FlotgThreadRequest, FLO_MESSAGE>
+
+  func makeListTopicsCall(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncServerStreamingCall<FlotgSourceRequest, FlotgTopic>
+
+  func makeAuthBeginCall(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncUnaryCall<FlotgAuthRequest, FlotgAuthStatus>
+
+  func makeAuthSubmitCodeCall(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncUnaryCall<FlotgAuthRequest, FlotgAuthStatus>
+
+  func makeAuthSubmitPasswordCall(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncUnaryCall<FlotgAuthRequest, FlotgAuthStatus>
+
+  func makeAuthGetStatusCall(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions?
+  ) -> GRPCAsyncUnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgAuthStatus>
 }
 
 @available(macOS 10.15, iOS 13, tvOS 13, watchOS 6, *)
@@ -191,7 +583,7 @@ extension FlotgServiceAsyncClientProtocol {
   public func makeReadyCall(
     _ request: SwiftProtobuf.Google_Protobuf_Empty,
     callOptions: CallOptions? = nil
-  ) -> GRPCAsyncUnaryCall<SwiftProtobuf.Google_Protobuf_Empty, SwiftProtobuf.Google_Protobuf_Empty> {
+  ) -> GRPCAsyncUnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgReadyResponse> {
     return self.makeAsyncUnaryCall(
       path: FlotgServiceClientMetadata.Methods.ready.path,
       request: request,
@@ -223,6 +615,162 @@ extension FlotgServiceAsyncClientProtocol {
       interceptors: self.interceptors?.makeGetMessagesInterceptors() ?? []
     )
   }
+
+  public func makeStreamMessagesCall(
+    _ request: FlotgStreamMessagesRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncServerStreamingCall<FlotgStreamMessagesRequest, FLO_MESSAGE> {
+    return self.makeAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.streamMessages.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeStreamMessagesInterceptors() ?? []
+    )
+  }
+
+  public func makeEnableMonitoringCall(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncUnaryCall<FlotgSourceRequest, FLO_SOURCE> {
+    return self.makeAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.enableMonitoring.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeEnableMonitoringInterceptors() ?? []
+    )
+  }
+
+  public func makeDisableMonitoringCall(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncUnaryCall<FlotgSourceRequest, FLO_SOURCE> {
+    return self.makeAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.disableMonitoring.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeDisableMonitoringInterceptors() ?? []
+    )
+  }
+
+  public func makeGetMonitoredSourcesCall(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncServerStreamingCall<SwiftProtobuf.Google_Protobuf_Empty, FLO_SOURCE> {
+    return self.makeAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getMonitoredSources.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetMonitoredSourcesInterceptors() ?? []
+    )
+  }
+
+  public func makeBackfillSourceCall(
+    _ request: FlotgBackfillRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncServerStreamingCall<FlotgBackfillRequest, FlotgBackfillProgress> {
+    return self.makeAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.backfillSource.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeBackfillSourceInterceptors() ?? []
+    )
+  }
+
+  public func makeListDialogsCall(
+    _ request: FlotgListDialogsRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncServerStreamingCall<FlotgListDialogsRequest, FlotgDialog> {
+    return self.makeAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.listDialogs.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeListDialogsInterceptors() ?? []
+    )
+  }
+
+  public func makeGetAttachmentCall(
+    _ request: FlotgAttachmentRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncServerStreamingCall<FlotgAttachmentRequest, FlotgAttachmentChunk> {
+    return self.makeAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getAttachment.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetAttachmentInterceptors() ?? []
+    )
+  }
+
+  public func makeGetThreadCall(
+    _ request: FlotgThreadRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncServerStreamingCall<FlotgThreadRequest, FLO_MESSAGE> {
+    return self.makeAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getThread.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetThreadInterceptors() ?? []
+    )
+  }
+
+  public func makeListTopicsCall(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncServerStreamingCall<FlotgSourceRequest, FlotgTopic> {
+    return self.makeAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.listTopics.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeListTopicsInterceptors() ?? []
+    )
+  }
+
+  public func makeAuthBeginCall(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncUnaryCall<FlotgAuthRequest, FlotgAuthStatus> {
+    return self.makeAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authBegin.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthBeginInterceptors() ?? []
+    )
+  }
+
+  public func makeAuthSubmitCodeCall(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncUnaryCall<FlotgAuthRequest, FlotgAuthStatus> {
+    return self.makeAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authSubmitCode.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthSubmitCodeInterceptors() ?? []
+    )
+  }
+
+  public func makeAuthSubmitPasswordCall(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncUnaryCall<FlotgAuthRequest, FlotgAuthStatus> {
+    return self.makeAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authSubmitPassword.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthSubmitPasswordInterceptors() ?? []
+    )
+  }
+
+  public func makeAuthGetStatusCall(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncUnaryCall<SwiftProtobuf.Google_Protobuf_Empty, FlotgAuthStatus> {
+    return self.makeAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authGetStatus.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthGetStatusInterceptors() ?? []
+    )
+  }
 }
 
 @available(macOS 10.15, iOS 13, tvOS 13, watchOS 6, *)
@@ -230,7 +778,7 @@ extension FlotgServiceAsyncClientProtocol {
   public func ready(
     _ request: SwiftProtobuf.Google_Protobuf_Empty,
     callOptions: CallOptions? = nil
-  ) async throws -> SwiftProtobuf.Google_Protobuf_Empty {
+  ) async throws -> FlotgReadyResponse {
     return try await self.performAsyncUnaryCall(
       path: FlotgServiceClientMetadata.Methods.ready.path,
       request: request,
@@ -262,6 +810,162 @@ extension FlotgServiceAsyncClientProtocol {
       interceptors: self.interceptors?.makeGetMessagesInterceptors() ?? []
     )
   }
+
+  public func streamMessages(
+    _ request: FlotgStreamMessagesRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncResponseStream<FLO_MESSAGE> {
+    return self.performAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.streamMessages.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeStreamMessagesInterceptors() ?? []
+    )
+  }
+
+  public func enableMonitoring(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) async throws -> FLO_SOURCE {
+    return try await self.performAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.enableMonitoring.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeEnableMonitoringInterceptors() ?? []
+    )
+  }
+
+  public func disableMonitoring(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) async throws -> FLO_SOURCE {
+    return try await self.performAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.disableMonitoring.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeDisableMonitoringInterceptors() ?? []
+    )
+  }
+
+  public func getMonitoredSources(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncResponseStream<FLO_SOURCE> {
+    return self.performAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getMonitoredSources.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetMonitoredSourcesInterceptors() ?? []
+    )
+  }
+
+  public func backfillSource(
+    _ request: FlotgBackfillRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncResponseStream<FlotgBackfillProgress> {
+    return self.performAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.backfillSource.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeBackfillSourceInterceptors() ?? []
+    )
+  }
+
+  public func listDialogs(
+    _ request: FlotgListDialogsRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncResponseStream<FlotgDialog> {
+    return self.performAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.listDialogs.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeListDialogsInterceptors() ?? []
+    )
+  }
+
+  public func getAttachment(
+    _ request: FlotgAttachmentRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncResponseStream<FlotgAttachmentChunk> {
+    return self.performAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getAttachment.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetAttachmentInterceptors() ?? []
+    )
+  }
+
+  public func getThread(
+    _ request: FlotgThreadRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncResponseStream<FLO_MESSAGE> {
+    return self.performAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.getThread.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeGetThreadInterceptors() ?? []
+    )
+  }
+
+  public func listTopics(
+    _ request: FlotgSourceRequest,
+    callOptions: CallOptions? = nil
+  ) -> GRPCAsyncResponseStream<FlotgTopic> {
+    return self.performAsyncServerStreamingCall(
+      path: FlotgServiceClientMetadata.Methods.listTopics.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeListTopicsInterceptors() ?? []
+    )
+  }
+
+  public func authBegin(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) async throws -> FlotgAuthStatus {
+    return try await self.performAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authBegin.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthBeginInterceptors() ?? []
+    )
+  }
+
+  public func authSubmitCode(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) async throws -> FlotgAuthStatus {
+    return try await self.performAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authSubmitCode.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthSubmitCodeInterceptors() ?? []
+    )
+  }
+
+  public func authSubmitPassword(
+    _ request: FlotgAuthRequest,
+    callOptions: CallOptions? = nil
+  ) async throws -> FlotgAuthStatus {
+    return try await self.performAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authSubmitPassword.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthSubmitPasswordInterceptors() ?? []
+    )
+  }
+
+  public func authGetStatus(
+    _ request: SwiftProtobuf.Google_Protobuf_Empty,
+    callOptions: CallOptions? = nil
+  ) async throws -> FlotgAuthStatus {
+    return try await self.performAsyncUnaryCall(
+      path: FlotgServiceClientMetadata.Methods.authGetStatus.path,
+      request: request,
+      callOptions: callOptions ?? self.defaultCallOptions,
+      interceptors: self.interceptors?.makeAuthGetStatusInterceptors() ?? []
+    )
+  }
 }
 
 @available(macOS 10.15, iOS 13, tvOS 13, watchOS 6, *)
@@ -284,13 +988,52 @@ public struct FlotgServiceAsyncClient: FlotgServiceAsyncClientProtocol {
 public protocol FlotgServiceClientInterceptorFactoryProtocol: Sendable {
 
   /// - Returns: Interceptors to use when invoking 'ready'.
-  func makeReadyInterceptors() -> [ClientInterceptor<SwiftProtobuf.Google_Protobuf_Empty, SwiftProtobuf.Google_Protobuf_Empty>]
+  func makeReadyInterceptors() -> [ClientInterceptor<SwiftProtobuf.Google_Protobuf_Empty, FlotgReadyResponse>]
 
   /// - Returns: Interceptors to use when invoking 'getSources'.
   func makeGetSourcesInterceptors() -> [ClientInterceptor<FlotgGetSourcesRequest, FLO_SOURCE>]
 
   /// - Returns: Interceptors to use when invoking 'getMessages'.
   func makeGetMessagesInterceptors() -> [ClientInterceptor<FlotgGetMessagesRequest, FLO_MESSAGE>]
+
+  /// - Returns: Interceptors to use when invoking 'streamMessages'.
+  func makeStreamMessagesInterceptors() -> [ClientInterceptor<FlotgStreamMessagesRequest, FLO_MESSAGE>]
+
+  /// - Returns: Interceptors to use when invoking 'enableMonitoring'.
+  func makeEnableMonitoringInterceptors() -> [ClientInterceptor<FlotgSourceRequest, FLO_SOURCE>]
+
+  /// - Returns: Interceptors to use when invoking 'disableMonitoring'.
+  func makeDisableMonitoringInterceptors() -> [ClientInterceptor<FlotgSourceRequest, FLO_SOURCE>]
+
+  /// - Returns: Interceptors to use when invoking 'getMonitoredSources'.
+  func makeGetMonitoredSourcesInterceptors() -> [ClientInterceptor<SwiftProtobuf.Google_Protobuf_Empty, FLO_SOURCE>]
+
+  /// - Returns: Interceptors to use when invoking 'backfillSource'.
+  func makeBackfillSourceInterceptors() -> [ClientInterceptor<FlotgBackfillRequest, FlotgBackfillProgress>]
+
+  /// - Returns: Interceptors to use when invoking 'listDialogs'.
+  func makeListDialogsInterceptors() -> [ClientInterceptor<FlotgListDialogsRequest, FlotgDialog>]
+
+  /// - Returns: Interceptors to use when invoking 'getAttachment'.
+  func makeGetAttachmentInterceptors() -> [ClientInterceptor<FlotgAttachmentRequest, FlotgAttachmentChunk>]
+
+  /// - Returns: Interceptors to use when invoking 'getThread'.
+  func makeGetThreadInterceptors() -> [ClientInterceptor<FlotgThreadRequest, FLO_MESSAGE>]
+
+  /// - Returns: Interceptors to use when invoking 'listTopics'.
+  func makeListTopicsInterceptors() -> [ClientInterceptor<FlotgSourceRequest, FlotgTopic>]
+
+  /// - Returns: Interceptors to use when invoking 'authBegin'.
+  func makeAuthBeginInterceptors() -> [ClientInterceptor<FlotgAuthRequest, FlotgAuthStatus>]
+
+  /// - Returns: Interceptors to use when invoking 'authSubmitCode'.
+  func makeAuthSubmitCodeInterceptors() -> [ClientInterceptor<FlotgAuthRequest, FlotgAuthStatus>]
+
+  /// - Returns: Interceptors to use when invoking 'authSubmitPassword'.
+  func makeAuthSubmitPasswordInterceptors() -> [ClientInterceptor<FlotgAuthRequest, FlotgAuthStatus>]
+
+  /// - Returns: Interceptors to use when invoking 'authGetStatus'.
+  func makeAuthGetStatusInterceptors() -> [ClientInterceptor<SwiftProtobuf.Google_Protobuf_Empty, FlotgAuthStatus>]
 }
 
 public enum FlotgServiceClientMetadata {
@@ -301,6 +1044,19 @@ public enum FlotgServiceClientMetadata {
       FlotgServiceClientMetadata.Methods.ready,
       FlotgServiceClientMetadata.Methods.getSources,
       FlotgServiceClientMetadata.Methods.getMessages,
+      FlotgServiceClientMetadata.Methods.streamMessages,
+      FlotgServiceClientMetadata.Methods.enableMonitoring,
+      FlotgServiceClientMetadata.Methods.disableMonitoring,
+      FlotgServiceClientMetadata.Methods.getMonitoredSources,
+      FlotgServiceClientMetadata.Methods.backfillSource,
+      FlotgServiceClientMetadata.Methods.listDialogs,
+      FlotgServiceClientMetadata.Methods.getAttachment,
+      FlotgServiceClientMetadata.Methods.getThread,
+      FlotgServiceClientMetadata.Methods.listTopics,
+      FlotgServiceClientMetadata.Methods.authBegin,
+      FlotgServiceClientMetadata.Methods.authSubmitCode,
+      FlotgServiceClientMetadata.Methods.authSubmitPassword,
+      FlotgServiceClientMetadata.Methods.authGetStatus,
     ]
   )
 
@@ -311,17 +1067,95 @@ public enum FlotgServiceClientMetadata {
       type: GRPCCallType.unary
     )
 
-    public static let getSources = GRPCMethodDescriptor(
-      name: "GetSources",
-      path: "/FlotgService/GetSources",
+    public static let getSources = GRPCMethodDescriptor(
+      name: "GetSources",
+      path: "/FlotgService/GetSources",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let getMessages = GRPCMethodDescriptor(
+      name: "GetMessages",
+      path: "/FlotgService/GetMessages",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let streamMessages = GRPCMethodDescriptor(
+      name: "StreamMessages",
+      path: "/FlotgService/StreamMessages",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let enableMonitoring = GRPCMethodDescriptor(
+      name: "EnableMonitoring",
+      path: "/FlotgService/EnableMonitoring",
+      type: GRPCCallType.unary
+    )
+
+    public static let disableMonitoring = GRPCMethodDescriptor(
+      name: "DisableMonitoring",
+      path: "/FlotgService/DisableMonitoring",
+      type: GRPCCallType.unary
+    )
+
+    public static let getMonitoredSources = GRPCMethodDescriptor(
+      name: "GetMonitoredSources",
+      path: "/FlotgService/GetMonitoredSources",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let backfillSource = GRPCMethodDescriptor(
+      name: "BackfillSource",
+      path: "/FlotgService/BackfillSource",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let listDialogs = GRPCMethodDescriptor(
+      name: "ListDialogs",
+      path: "/FlotgService/ListDialogs",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let getAttachment = GRPCMethodDescriptor(
+      name: "GetAttachment",
+      path: "/FlotgService/GetAttachment",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let getThread = GRPCMethodDescriptor(
+      name: "GetThread",
+      path: "/FlotgService/GetThread",
       type: GRPCCallType.serverStreaming
     )
 
-    public static let getMessages = GRPCMethodDescriptor(
-      name: "GetMessages",
-      path: "/FlotgService/GetMessages",
+    public static let listTopics = GRPCMethodDescriptor(
+      name: "ListTopics",
+      path: "/FlotgService/ListTopics",
       type: GRPCCallType.serverStreaming
     )
+
+    public static let authBegin = GRPCMethodDescriptor(
+      name: "AuthBegin",
+      path: "/FlotgService/AuthBegin",
+      type: GRPCCallType.unary
+    )
+
+    public static let authSubmitCode = GRPCMethodDescriptor(
+      name: "AuthSubmitCode",
+      path: "/FlotgService/AuthSubmitCode",
+      type: GRPCCallType.unary
+    )
+
+    public static let authSubmitPassword = GRPCMethodDescriptor(
+      name: "AuthSubmitPassword",
+      path: "/FlotgService/AuthSubmitPassword",
+      type: GRPCCallType.unary
+    )
+
+    public static let authGetStatus = GRPCMethodDescriptor(
+      name: "AuthGetStatus",
+      path: "/FlotgService/AuthGetStatus",
+      type: GRPCCallType.unary
+    )
   }
 }
 
@@ -705,11 +1539,38 @@ public enum FloRssServiceClientMetadata {
 public protocol FlotgServiceProvider: CallHandlerProvider {
   var interceptors: FlotgServiceServerInterceptorFactoryProtocol? { get }
 
-  func ready(request: SwiftProtobuf.Google_Protobuf_Empty, context: StatusOnlyCallContext) -> EventLoopFuture<SwiftProtobuf.Google_Protobuf_Empty>
+  func ready(request: SwiftProtobuf.Google_Protobuf_Empty, context: StatusOnlyCallContext) -> EventLoopFuture<FlotgReadyResponse>
 
   func getSources(request: FlotgGetSourcesRequest, context: StreamingResponseCallContext<FLO_SOURCE>) -> EventLoopFuture<GRPCStatus>
 
   func getMessages(request: FlotgGetMessagesRequest, context: StreamingResponseCallContext<FLO_MESSAGE>) -> EventLoopFuture<GRPCStatus>
+
+  func streamMessages(request: FlotgStreamMessagesRequest, context: StreamingResponseCallContext<FLO_MESSAGE>) -> EventLoopFuture<GRPCStatus>
+
+  func enableMonitoring(request: FlotgSourceRequest, context: StatusOnlyCallContext) -> EventLoopFuture<FLO_SOURCE>
+
+  func disableMonitoring(request: FlotgSourceRequest, context: StatusOnlyCallContext) -> EventLoopFuture<FLO_SOURCE>
+
+  func getMonitoredSources(request: SwiftProtobuf.Google_Protobuf_Empty, context: StreamingResponseCallContext<FLO_SOURCE>) -> EventLoopFuture<GRPCStatus>
+
+  func backfillSource(request: FlotgBackfillRequest, context: StreamingResponseCallContext<FlotgBackfillProgress>) -> EventLoopFuture<GRPCStatus>
+
+  func listDialogs(request: FlotgListDialogsRequest, context: StreamingResponseCallContext<FlotgDialog>) -> EventLoopFuture<GRPCStatus>
+
+  func getAttachment(request: FlotgAttachmentRequest, context: StreamingResponseCallContext<FlotgAttachmentChunk>) -> EventLoopFuture<GRPCStatus>
+
+  func getThread(request: FlotgThreadRequest, context: StreamingResponseCallContext<FLO_MESSAGE>) -> EventLoopFuture<GRPCStatus>
+
+  func listTopics(request: FlotgSourceRequest, context: StreamingResponseCallContext<FlotgTopic>) -> EventLoopFuture<GRPCStatus>
+
+  /// Telegram login, when flo_tg runs with TG_AUTH=rpc
+  func authBegin(request: FlotgAuthRequest, context: StatusOnlyCallContext) -> EventLoopFuture<FlotgAuthStatus>
+
+  func authSubmitCode(request: FlotgAuthRequest, context: StatusOnlyCallContext) -> EventLoopFuture<FlotgAuthStatus>
+
+  func authSubmitPassword(request: FlotgAuthRequest, context: StatusOnlyCallContext) -> EventLoopFuture<FlotgAuthStatus>
+
+  func authGetStatus(request: SwiftProtobuf.Google_Protobuf_Empty, context: StatusOnlyCallContext) -> EventLoopFuture<FlotgAuthStatus>
 }
 
 extension FlotgServiceProvider {
@@ -728,7 +1589,7 @@ extension FlotgServiceProvider {
       return UnaryServerHandler(
         context: context,
         requestDeserializer: ProtobufDeserializer<SwiftProtobuf.Google_Protobuf_Empty>(),
-        responseSerializer: ProtobufSerializer<SwiftProtobuf.Google_Protobuf_Empty>(),
+        responseSerializer: ProtobufSerializer<FlotgReadyResponse>(),
         interceptors: self.interceptors?.makeReadyInterceptors() ?? [],
         userFunction: self.ready(request:context:)
       )
@@ -751,6 +1612,123 @@ extension FlotgServiceProvider {
         userFunction: self.getMessages(request:context:)
       )
 
+    case "StreamMessages":
+      return ServerStreamingServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgStreamMessagesRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_MESSAGE>(),
+        interceptors: self.interceptors?.makeStreamMessagesInterceptors() ?? [],
+        userFunction: self.streamMessages(request:context:)
+      )
+
+    case "EnableMonitoring":
+      return UnaryServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgSourceRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_SOURCE>(),
+        interceptors: self.interceptors?.makeEnableMonitoringInterceptors() ?? [],
+        userFunction: self.enableMonitoring(request:context:)
+      )
+
+    case "DisableMonitoring":
+      return UnaryServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgSourceRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_SOURCE>(),
+        interceptors: self.interceptors?.makeDisableMonitoringInterceptors() ?? [],
+        userFunction: self.disableMonitoring(request:context:)
+      )
+
+    case "GetMonitoredSources":
+      return ServerStreamingServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<SwiftProtobuf.Google_Protobuf_Empty>(),
+        responseSerializer: ProtobufSerializer<FLO_SOURCE>(),
+        interceptors: self.interceptors?.makeGetMonitoredSourcesInterceptors() ?? [],
+        userFunction: self.getMonitoredSources(request:context:)
+      )
+
+    case "BackfillSource":
+      return ServerStreamingServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgBackfillRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgBackfillProgress>(),
+        interceptors: self.interceptors?.makeBackfillSourceInterceptors() ?? [],
+        userFunction: self.backfillSource(request:context:)
+      )
+
+    case "ListDialogs":
+      return ServerStreamingServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgListDialogsRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgDialog>(),
+        interceptors: self.interceptors?.makeListDialogsInterceptors() ?? [],
+        userFunction: self.listDialogs(request:context:)
+      )
+
+    case "GetAttachment":
+      return ServerStreamingServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAttachmentRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAttachmentChunk>(),
+        interceptors: self.interceptors?.makeGetAttachmentInterceptors() ?? [],
+        userFunction: self.getAttachment(request:context:)
+      )
+
+    case "GetThread":
+      return ServerStreamingServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgThreadRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_MESSAGE>(),
+        interceptors: self.interceptors?.makeGetThreadInterceptors() ?? [],
+        userFunction: self.getThread(request:context:)
+      )
+
+    case "ListTopics":
+      return ServerStreamingServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgSourceRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgTopic>(),
+        interceptors: self.interceptors?.makeListTopicsInterceptors() ?? [],
+        userFunction: self.listTopics(request:context:)
+      )
+
+    case "AuthBegin":
+      return UnaryServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAuthRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthBeginInterceptors() ?? [],
+        userFunction: self.authBegin(request:context:)
+      )
+
+    case "AuthSubmitCode":
+      return UnaryServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAuthRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthSubmitCodeInterceptors() ?? [],
+        userFunction: self.authSubmitCode(request:context:)
+      )
+
+    case "AuthSubmitPassword":
+      return UnaryServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAuthRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthSubmitPasswordInterceptors() ?? [],
+        userFunction: self.authSubmitPassword(request:context:)
+      )
+
+    case "AuthGetStatus":
+      return UnaryServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<SwiftProtobuf.Google_Protobuf_Empty>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthGetStatusInterceptors() ?? [],
+        userFunction: self.authGetStatus(request:context:)
+      )
+
     default:
       return nil
     }
@@ -766,7 +1744,7 @@ public protocol FlotgServiceAsyncProvider: CallHandlerProvider, Sendable {
   func ready(
     request: SwiftProtobuf.Google_Protobuf_Empty,
     context: GRPCAsyncServerCallContext
-  ) async throws -> SwiftProtobuf.Google_Protobuf_Empty
+  ) async throws -> FlotgReadyResponse
 
   func getSources(
     request: FlotgGetSourcesRequest,
@@ -779,6 +1757,79 @@ public protocol FlotgServiceAsyncProvider: CallHandlerProvider, Sendable {
     responseStream: GRPCAsyncResponseStreamWriter<FLO_MESSAGE>,
     context: GRPCAsyncServerCallContext
   ) async throws
+
+  func streamMessages(
+    request: FlotgStreamMessagesRequest,
+    responseStream: GRPCAsyncResponseStreamWriter<FLO_MESSAGE>,
+    context: GRPCAsyncServerCallContext
+  ) async throws
+
+  func enableMonitoring(
+    request: FlotgSourceRequest,
+    context: GRPCAsyncServerCallContext
+  ) async throws -> FLO_SOURCE
+
+  func disableMonitoring(
+    request: FlotgSourceRequest,
+    context: GRPCAsyncServerCallContext
+  ) async throws -> FLO_SOURCE
+
+  func getMonitoredSources(
+    request: SwiftProtobuf.Google_Protobuf_Empty,
+    responseStream: GRPCAsyncResponseStreamWriter<FLO_SOURCE>,
+    context: GRPCAsyncServerCallContext
+  ) async throws
+
+  func backfillSource(
+    request: FlotgBackfillRequest,
+    responseStream: GRPCAsyncResponseStreamWriter<FlotgBackfillProgress>,
+    context: GRPCAsyncServerCallContext
+  ) async throws
+
+  func listDialogs(
+    request: FlotgListDialogsRequest,
+    responseStream: GRPCAsyncResponseStreamWriter<FlotgDialog>,
+    context: GRPCAsyncServerCallContext
+  ) async throws
+
+  func getAttachment(
+    request: FlotgAttachmentRequest,
+    responseStream: GRPCAsyncResponseStreamWriter<FlotgAttachmentChunk>,
+    context: GRPCAsyncServerCallContext
+  ) async throws
+
+  func getThread(
+    request: FlotgThreadRequest,
+    responseStream: GRPCAsyncResponseStreamWriter<FLO_MESSAGE>,
+    context: GRPCAsyncServerCallContext
+  ) async throws
+
+  func listTopics(
+    request: FlotgSourceRequest,
+    responseStream: GRPCAsyncResponseStreamWriter<FlotgTopic>,
+    context: GRPCAsyncServerCallContext
+  ) async throws
+
+  /// Telegram login, when flo_tg runs with TG_AUTH=rpc
+  func authBegin(
+    request: FlotgAuthRequest,
+    context: GRPCAsyncServerCallContext
+  ) async throws -> FlotgAuthStatus
+
+  func authSubmitCode(
+    request: FlotgAuthRequest,
+    context: GRPCAsyncServerCallContext
+  ) async throws -> FlotgAuthStatus
+
+  func authSubmitPassword(
+    request: FlotgAuthRequest,
+    context: GRPCAsyncServerCallContext
+  ) async throws -> FlotgAuthStatus
+
+  func authGetStatus(
+    request: SwiftProtobuf.Google_Protobuf_Empty,
+    context: GRPCAsyncServerCallContext
+  ) async throws -> FlotgAuthStatus
 }
 
 @available(macOS 10.15, iOS 13, tvOS 13, watchOS 6, *)
@@ -804,7 +1855,7 @@ extension FlotgServiceAsyncProvider {
       return GRPCAsyncServerHandler(
         context: context,
         requestDeserializer: ProtobufDeserializer<SwiftProtobuf.Google_Protobuf_Empty>(),
-        responseSerializer: ProtobufSerializer<SwiftProtobuf.Google_Protobuf_Empty>(),
+        responseSerializer: ProtobufSerializer<FlotgReadyResponse>(),
         interceptors: self.interceptors?.makeReadyInterceptors() ?? [],
         wrapping: { try await self.ready(request: $0, context: $1) }
       )
@@ -827,6 +1878,123 @@ extension FlotgServiceAsyncProvider {
         wrapping: { try await self.getMessages(request: $0, responseStream: $1, context: $2) }
       )
 
+    case "StreamMessages":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgStreamMessagesRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_MESSAGE>(),
+        interceptors: self.interceptors?.makeStreamMessagesInterceptors() ?? [],
+        wrapping: { try await self.streamMessages(request: $0, responseStream: $1, context: $2) }
+      )
+
+    case "EnableMonitoring":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgSourceRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_SOURCE>(),
+        interceptors: self.interceptors?.makeEnableMonitoringInterceptors() ?? [],
+        wrapping: { try await self.enableMonitoring(request: $0, context: $1) }
+      )
+
+    case "DisableMonitoring":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgSourceRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_SOURCE>(),
+        interceptors: self.interceptors?.makeDisableMonitoringInterceptors() ?? [],
+        wrapping: { try await self.disableMonitoring(request: $0, context: $1) }
+      )
+
+    case "GetMonitoredSources":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<SwiftProtobuf.Google_Protobuf_Empty>(),
+        responseSerializer: ProtobufSerializer<FLO_SOURCE>(),
+        interceptors: self.interceptors?.makeGetMonitoredSourcesInterceptors() ?? [],
+        wrapping: { try await self.getMonitoredSources(request: $0, responseStream: $1, context: $2) }
+      )
+
+    case "BackfillSource":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgBackfillRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgBackfillProgress>(),
+        interceptors: self.interceptors?.makeBackfillSourceInterceptors() ?? [],
+        wrapping: { try await self.backfillSource(request: $0, responseStream: $1, context: $2) }
+      )
+
+    case "ListDialogs":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgListDialogsRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgDialog>(),
+        interceptors: self.interceptors?.makeListDialogsInterceptors() ?? [],
+        wrapping: { try await self.listDialogs(request: $0, responseStream: $1, context: $2) }
+      )
+
+    case "GetAttachment":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAttachmentRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAttachmentChunk>(),
+        interceptors: self.interceptors?.makeGetAttachmentInterceptors() ?? [],
+        wrapping: { try await self.getAttachment(request: $0, responseStream: $1, context: $2) }
+      )
+
+    case "GetThread":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgThreadRequest>(),
+        responseSerializer: ProtobufSerializer<FLO_MESSAGE>(),
+        interceptors: self.interceptors?.makeGetThreadInterceptors() ?? [],
+        wrapping: { try await self.getThread(request: $0, responseStream: $1, context: $2) }
+      )
+
+    case "ListTopics":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgSourceRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgTopic>(),
+        interceptors: self.interceptors?.makeListTopicsInterceptors() ?? [],
+        wrapping: { try await self.listTopics(request: $0, responseStream: $1, context: $2) }
+      )
+
+    case "AuthBegin":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAuthRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthBeginInterceptors() ?? [],
+        wrapping: { try await self.authBegin(request: $0, context: $1) }
+      )
+
+    case "AuthSubmitCode":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAuthRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthSubmitCodeInterceptors() ?? [],
+        wrapping: { try await self.authSubmitCode(request: $0, context: $1) }
+      )
+
+    case "AuthSubmitPassword":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<FlotgAuthRequest>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthSubmitPasswordInterceptors() ?? [],
+        wrapping: { try await self.authSubmitPassword(request: $0, context: $1) }
+      )
+
+    case "AuthGetStatus":
+      return GRPCAsyncServerHandler(
+        context: context,
+        requestDeserializer: ProtobufDeserializer<SwiftProtobuf.Google_Protobuf_Empty>(),
+        responseSerializer: ProtobufSerializer<FlotgAuthStatus>(),
+        interceptors: self.interceptors?.makeAuthGetStatusInterceptors() ?? [],
+        wrapping: { try await self.authGetStatus(request: $0, context: $1) }
+      )
+
     default:
       return nil
     }
@@ -837,7 +2005,7 @@ public protocol FlotgServiceServerInterceptorFactoryProtocol: Sendable {
 
   /// - Returns: Interceptors to use when handling 'ready'.
   ///   Defaults to calling `self.makeInterceptors()`.
-  func makeReadyInterceptors() -> [ServerInterceptor<SwiftProtobuf.Google_Protobuf_Empty, SwiftProtobuf.Google_Protobuf_Empty>]
+  func makeReadyInterceptors() -> [ServerInterceptor<SwiftProtobuf.Google_Protobuf_Empty, FlotgReadyResponse>]
 
   /// - Returns: Interceptors to use when handling 'getSources'.
   ///   Defaults to calling `self.makeInterceptors()`.
@@ -846,6 +2014,58 @@ public protocol FlotgServiceServerInterceptorFactoryProtocol: Sendable {
   /// - Returns: Interceptors to use when handling 'getMessages'.
   ///   Defaults to calling `self.makeInterceptors()`.
   func makeGetMessagesInterceptors() -> [ServerInterceptor<FlotgGetMessagesRequest, FLO_MESSAGE>]
+
+  /// - Returns: Interceptors to use when handling 'streamMessages'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeStreamMessagesInterceptors() -> [ServerInterceptor<FlotgStreamMessagesRequest, FLO_MESSAGE>]
+
+  /// - Returns: Interceptors to use when handling 'enableMonitoring'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeEnableMonitoringInterceptors() -> [ServerInterceptor<FlotgSourceRequest, FLO_SOURCE>]
+
+  /// - Returns: Interceptors to use when handling 'disableMonitoring'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeDisableMonitoringInterceptors() -> [ServerInterceptor<FlotgSourceRequest, FLO_SOURCE>]
+
+  /// - Returns: Interceptors to use when handling 'getMonitoredSources'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeGetMonitoredSourcesInterceptors() -> [ServerInterceptor<SwiftProtobuf.Google_Protobuf_Empty, FLO_SOURCE>]
+
+  /// - Returns: Interceptors to use when handling 'backfillSource'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeBackfillSourceInterceptors() -> [ServerInterceptor<FlotgBackfillRequest, FlotgBackfillProgress>]
+
+  /// - Returns: Interceptors to use when handling 'listDialogs'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeListDialogsInterceptors() -> [ServerInterceptor<FlotgListDialogsRequest, FlotgDialog>]
+
+  /// - Returns: Interceptors to use when handling 'getAttachment'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeGetAttachmentInterceptors() -> [ServerInterceptor<FlotgAttachmentRequest, FlotgAttachmentChunk>]
+
+  /// - Returns: Interceptors to use when handling 'getThread'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeGetThreadInterceptors() -> [ServerInterceptor<FlotgThreadRequest, FLO_MESSAGE>]
+
+  /// - Returns: Interceptors to use when handling 'listTopics'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeListTopicsInterceptors() -> [ServerInterceptor<FlotgSourceRequest, FlotgTopic>]
+
+  /// - Returns: Interceptors to use when handling 'authBegin'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeAuthBeginInterceptors() -> [ServerInterceptor<FlotgAuthRequest, FlotgAuthStatus>]
+
+  /// - Returns: Interceptors to use when handling 'authSubmitCode'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeAuthSubmitCodeInterceptors() -> [ServerInterceptor<FlotgAuthRequest, FlotgAuthStatus>]
+
+  /// - Returns: Interceptors to use when handling 'authSubmitPassword'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeAuthSubmitPasswordInterceptors() -> [ServerInterceptor<FlotgAuthRequest, FlotgAuthStatus>]
+
+  /// - Returns: Interceptors to use when handling 'authGetStatus'.
+  ///   Defaults to calling `self.makeInterceptors()`.
+  func makeAuthGetStatusInterceptors() -> [ServerInterceptor<SwiftProtobuf.Google_Protobuf_Empty, FlotgAuthStatus>]
 }
 
 public enum FlotgServiceServerMetadata {
@@ -856,6 +2076,19 @@ public enum FlotgServiceServerMetadata {
       FlotgServiceServerMetadata.Methods.ready,
       FlotgServiceServerMetadata.Methods.getSources,
       FlotgServiceServerMetadata.Methods.getMessages,
+      FlotgServiceServerMetadata.Methods.streamMessages,
+      FlotgServiceServerMetadata.Methods.enableMonitoring,
+      FlotgServiceServerMetadata.Methods.disableMonitoring,
+      FlotgServiceServerMetadata.Methods.getMonitoredSources,
+      FlotgServiceServerMetadata.Methods.backfillSource,
+      FlotgServiceServerMetadata.Methods.listDialogs,
+      FlotgServiceServerMetadata.Methods.getAttachment,
+      FlotgServiceServerMetadata.Methods.getThread,
+      FlotgServiceServerMetadata.Methods.listTopics,
+      FlotgServiceServerMetadata.Methods.authBegin,
+      FlotgServiceServerMetadata.Methods.authSubmitCode,
+      FlotgServiceServerMetadata.Methods.authSubmitPassword,
+      FlotgServiceServerMetadata.Methods.authGetStatus,
     ]
   )
 
@@ -877,6 +2110,84 @@ public enum FlotgServiceServerMetadata {
       path: "/FlotgService/GetMessages",
       type: GRPCCallType.serverStreaming
     )
+
+    public static let streamMessages = GRPCMethodDescriptor(
+      name: "StreamMessages",
+      path: "/FlotgService/StreamMessages",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let enableMonitoring = GRPCMethodDescriptor(
+      name: "EnableMonitoring",
+      path: "/FlotgService/EnableMonitoring",
+      type: GRPCCallType.unary
+    )
+
+    public static let disableMonitoring = GRPCMethodDescriptor(
+      name: "DisableMonitoring",
+      path: "/FlotgService/DisableMonitoring",
+      type: GRPCCallType.unary
+    )
+
+    public static let getMonitoredSources = GRPCMethodDescriptor(
+      name: "GetMonitoredSources",
+      path: "/FlotgService/GetMonitoredSources",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let backfillSource = GRPCMethodDescriptor(
+      name: "BackfillSource",
+      path: "/FlotgService/BackfillSource",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let listDialogs = GRPCMethodDescriptor(
+      name: "ListDialogs",
+      path: "/FlotgService/ListDialogs",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let getAttachment = GRPCMethodDescriptor(
+      name: "GetAttachment",
+      path: "/FlotgService/GetAttachment",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let getThread = GRPCMethodDescriptor(
+      name: "GetThread",
+      path: "/FlotgService/GetThread",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let listTopics = GRPCMethodDescriptor(
+      name: "ListTopics",
+      path: "/FlotgService/ListTopics",
+      type: GRPCCallType.serverStreaming
+    )
+
+    public static let authBegin = GRPCMethodDescriptor(
+      name: "AuthBegin",
+      path: "/FlotgService/AuthBegin",
+      type: GRPCCallType.unary
+    )
+
+    public static let authSubmitCode = GRPCMethodDescriptor(
+      name: "AuthSubmitCode",
+      path: "/FlotgService/AuthSubmitCode",
+      type: GRPCCallType.unary
+    )
+
+    public static let authSubmitPassword = GRPCMethodDescriptor(
+      name: "AuthSubmitPassword",
+      path: "/FlotgService/AuthSubmitPassword",
+      type: GRPCCallType.unary
+    )
+
+    public static let authGetStatus = GRPCMethodDescriptor(
+      name: "AuthGetStatus",
+      path: "/FlotgService/AuthGetStatus",
+      type: GRPCCallType.unary
+    )
   }
 }
 /// To build a server, implement a class that conforms to this protocol.
@@ -28,6 +28,28 @@ public enum FLAGS: SwiftProtobuf.Enum, Swift.CaseIterable {
   case user // = 4
   case group // = 8
   case channel // = 16
+
+  /// FLO_MESSAGE is forwarded, see forward_from_source
+  case forwardFromSource // = 32
+
+  /// FLO_SOURCE has a public username, see username
+  case tgUsername // = 64
+
+  /// FLO_MESSAGE was deleted in telegram, see deleted_at
+  case deleted // = 256
+
+  /// FLO_MESSAGE is a service message (pin, title change, join, call, ...), see service_action
+  case service // = 512
+
+  /// FLO_SOURCE telegram status, from telegram user or channel
+  case bot // = 1024
+  case scam // = 2048
+  case fake // = 4096
+  case verified // = 8192
+  case premium // = 16384
+
+  /// FLO_SOURCE is a forum supergroup, messages are in topics (see topic_id)
+  case forum // = 32768
   case UNRECOGNIZED(Int)
 
   public init() {
@@ -42,6 +64,16 @@ public enum FLAGS: SwiftProtobuf.Enum, Swift.CaseIterable {
     case 4: self = .user
     case 8: self = .group
     case 16: self = .channel
+    case 32: self = .forwardFromSource
+    case 64: self = .tgUsername
+    case 256: self = .deleted
+    case 512: self = .service
+    case 1024: self = .bot
+    case 2048: self = .scam
+    case 4096: self = .fake
+    case 8192: self = .verified
+    case 16384: self = .premium
+    case 32768: self = .forum
     default: self = .UNRECOGNIZED(rawValue)
     }
   }
@@ -54,6 +86,16 @@ public enum FLAGS: SwiftProtobuf.Enum, Swift.CaseIterable {
     case .user: return 4
     case .group: return 8
     case .channel: return 16
+    case .forwardFromSource: return 32
+    case .tgUsername: return 64
+    case .deleted: return 256
+    case .service: return 512
+    case .bot: return 1024
+    case .scam: return 2048
+    case .fake: return 4096
+    case .verified: return 8192
+    case .premium: return 16384
+    case .forum: return 32768
     case .UNRECOGNIZED(let i): return i
     }
   }
@@ -66,6 +108,404 @@ public enum FLAGS: SwiftProtobuf.Enum, Swift.CaseIterable {
     .user,
     .group,
     .channel,
+    .forwardFromSource,
+    .tgUsername,
+    .deleted,
+    .service,
+    .bot,
+    .scam,
+    .fake,
+    .verified,
+    .premium,
+    .forum,
+  ]
+
+}
+
+public enum FLO_SERVICE_ACTION_TYPE: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case actionUnknown // = 0
+  case actionPin // = 1
+  case actionTitleChange // = 2
+  case actionPhotoChange // = 3
+  case actionPhotoDelete // = 4
+  case actionMembersAdd // = 5
+  case actionMemberJoinByLink // = 6
+  case actionMemberJoinByRequest // = 7
+  case actionMemberLeave // = 8
+  case actionGroupCallStart // = 9
+  case actionGroupCallEnd // = 10
+  case actionGroupCallScheduled // = 11
+  case actionGroupCallInvite // = 12
+  case actionCreate // = 13
+  case actionMigrate // = 14
+  case actionHistoryClear // = 15
+  case actionPhoneCall // = 16
+  case actionTopicCreate // = 17
+  case actionTopicEdit // = 18
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .actionUnknown
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .actionUnknown
+    case 1: self = .actionPin
+    case 2: self = .actionTitleChange
+    case 3: self = .actionPhotoChange
+    case 4: self = .actionPhotoDelete
+    case 5: self = .actionMembersAdd
+    case 6: self = .actionMemberJoinByLink
+    case 7: self = .actionMemberJoinByRequest
+    case 8: self = .actionMemberLeave
+    case 9: self = .actionGroupCallStart
+    case 10: self = .actionGroupCallEnd
+    case 11: self = .actionGroupCallScheduled
+    case 12: self = .actionGroupCallInvite
+    case 13: self = .actionCreate
+    case 14: self = .actionMigrate
+    case 15: self = .actionHistoryClear
+    case 16: self = .actionPhoneCall
+    case 17: self = .actionTopicCreate
+    case 18: self = .actionTopicEdit
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .actionUnknown: return 0
+    case .actionPin: return 1
+    case .actionTitleChange: return 2
+    case .actionPhotoChange: return 3
+    case .actionPhotoDelete: return 4
+    case .actionMembersAdd: return 5
+    case .actionMemberJoinByLink: return 6
+    case .actionMemberJoinByRequest: return 7
+    case .actionMemberLeave: return 8
+    case .actionGroupCallStart: return 9
+    case .actionGroupCallEnd: return 10
+    case .actionGroupCallScheduled: return 11
+    case .actionGroupCallInvite: return 12
+    case .actionCreate: return 13
+    case .actionMigrate: return 14
+    case .actionHistoryClear: return 15
+    case .actionPhoneCall: return 16
+    case .actionTopicCreate: return 17
+    case .actionTopicEdit: return 18
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [FLO_SERVICE_ACTION_TYPE] = [
+    .actionUnknown,
+    .actionPin,
+    .actionTitleChange,
+    .actionPhotoChange,
+    .actionPhotoDelete,
+    .actionMembersAdd,
+    .actionMemberJoinByLink,
+    .actionMemberJoinByRequest,
+    .actionMemberLeave,
+    .actionGroupCallStart,
+    .actionGroupCallEnd,
+    .actionGroupCallScheduled,
+    .actionGroupCallInvite,
+    .actionCreate,
+    .actionMigrate,
+    .actionHistoryClear,
+    .actionPhoneCall,
+    .actionTopicCreate,
+    .actionTopicEdit,
+  ]
+
+}
+
+public enum FLO_ENTITY_TYPE: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case entityUnknown // = 0
+  case entityBold // = 1
+  case entityItalic // = 2
+  case entityUnderline // = 3
+  case entityStrike // = 4
+  case entitySpoiler // = 5
+  case entityCode // = 6
+  case entityPre // = 7
+  case entityBlockquote // = 8
+  case entityTextURL // = 9
+  case entityURL // = 10
+  case entityEmail // = 11
+  case entityPhone // = 12
+  case entityMention // = 13
+  case entityMentionName // = 14
+  case entityHashtag // = 15
+  case entityCashtag // = 16
+  case entityBotCommand // = 17
+  case entityBankCard // = 18
+  case entityCustomEmoji // = 19
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .entityUnknown
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .entityUnknown
+    case 1: self = .entityBold
+    case 2: self = .entityItalic
+    case 3: self = .entityUnderline
+    case 4: self = .entityStrike
+    case 5: self = .entitySpoiler
+    case 6: self = .entityCode
+    case 7: self = .entityPre
+    case 8: self = .entityBlockquote
+    case 9: self = .entityTextURL
+    case 10: self = .entityURL
+    case 11: self = .entityEmail
+    case 12: self = .entityPhone
+    case 13: self = .entityMention
+    case 14: self = .entityMentionName
+    case 15: self = .entityHashtag
+    case 16: self = .entityCashtag
+    case 17: self = .entityBotCommand
+    case 18: self = .entityBankCard
+    case 19: self = .entityCustomEmoji
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .entityUnknown: return 0
+    case .entityBold: return 1
+    case .entityItalic: return 2
+    case .entityUnderline: return 3
+    case .entityStrike: return 4
+    case .entitySpoiler: return 5
+    case .entityCode: return 6
+    case .entityPre: return 7
+    case .entityBlockquote: return 8
+    case .entityTextURL: return 9
+    case .entityURL: return 10
+    case .entityEmail: return 11
+    case .entityPhone: return 12
+    case .entityMention: return 13
+    case .entityMentionName: return 14
+    case .entityHashtag: return 15
+    case .entityCashtag: return 16
+    case .entityBotCommand: return 17
+    case .entityBankCard: return 18
+    case .entityCustomEmoji: return 19
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [FLO_ENTITY_TYPE] = [
+    .entityUnknown,
+    .entityBold,
+    .entityItalic,
+    .entityUnderline,
+    .entityStrike,
+    .entitySpoiler,
+    .entityCode,
+    .entityPre,
+    .entityBlockquote,
+    .entityTextURL,
+    .entityURL,
+    .entityEmail,
+    .entityPhone,
+    .entityMention,
+    .entityMentionName,
+    .entityHashtag,
+    .entityCashtag,
+    .entityBotCommand,
+    .entityBankCard,
+    .entityCustomEmoji,
+  ]
+
+}
+
+public enum FLO_ATTACHMENT_TYPE: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case attachmentUnknown // = 0
+  case attachmentPhoto // = 1
+  case attachmentDocument // = 2
+  case attachmentVideo // = 3
+  case attachmentVoice // = 4
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .attachmentUnknown
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .attachmentUnknown
+    case 1: self = .attachmentPhoto
+    case 2: self = .attachmentDocument
+    case 3: self = .attachmentVideo
+    case 4: self = .attachmentVoice
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .attachmentUnknown: return 0
+    case .attachmentPhoto: return 1
+    case .attachmentDocument: return 2
+    case .attachmentVideo: return 3
+    case .attachmentVoice: return 4
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [FLO_ATTACHMENT_TYPE] = [
+    .attachmentUnknown,
+    .attachmentPhoto,
+    .attachmentDocument,
+    .attachmentVideo,
+    .attachmentVoice,
+  ]
+
+}
+
+public enum FlotgDeletedFilter: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case deletedInclude // = 0
+  case deletedExclude // = 1
+  case deletedOnly // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .deletedInclude
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .deletedInclude
+    case 1: self = .deletedExclude
+    case 2: self = .deletedOnly
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .deletedInclude: return 0
+    case .deletedExclude: return 1
+    case .deletedOnly: return 2
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [FlotgDeletedFilter] = [
+    .deletedInclude,
+    .deletedExclude,
+    .deletedOnly,
+  ]
+
+}
+
+public enum FlotgAuthState: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case authUnknown // = 0
+  case authChecking // = 1
+  case authWaitingBegin // = 2
+  case authSendingCode // = 3
+  case authWaitingCode // = 4
+  case authWaitingPassword // = 5
+  case authSigningIn // = 6
+  case authAuthorized // = 7
+  case authFailed // = 8
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .authUnknown
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .authUnknown
+    case 1: self = .authChecking
+    case 2: self = .authWaitingBegin
+    case 3: self = .authSendingCode
+    case 4: self = .authWaitingCode
+    case 5: self = .authWaitingPassword
+    case 6: self = .authSigningIn
+    case 7: self = .authAuthorized
+    case 8: self = .authFailed
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .authUnknown: return 0
+    case .authChecking: return 1
+    case .authWaitingBegin: return 2
+    case .authSendingCode: return 3
+    case .authWaitingCode: return 4
+    case .authWaitingPassword: return 5
+    case .authSigningIn: return 6
+    case .authAuthorized: return 7
+    case .authFailed: return 8
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [FlotgAuthState] = [
+    .authUnknown,
+    .authChecking,
+    .authWaitingBegin,
+    .authSendingCode,
+    .authWaitingCode,
+    .authWaitingPassword,
+    .authSigningIn,
+    .authAuthorized,
+    .authFailed,
+  ]
+
+}
+
+public enum FlotgSortOrder: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case sortAscending // = 0
+  case sortDescending // = 1
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .sortAscending
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .sortAscending
+    case 1: self = .sortDescending
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .sortAscending: return 0
+    case .sortDescending: return 1
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [FlotgSortOrder] = [
+    .sortAscending,
+    .sortDescending,
   ]
 
 }
@@ -81,129 +521,2204 @@ public struct FLO_SOURCE: Sendable {
 
   public var title: String = String()
 
+  /// New messages are saved/streamed only for monitored sources
+  public var monitored: Bool = false
+
+  /// Public telegram username without "@" (TgUsername flag is set), https://t.me/<username>
+  public var username: String = String()
+
+  /// Past titles, oldest first. Only set on request.
+  public var titleHistory: [FLO_SOURCE_TITLE] = []
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
-public struct FLO_MESSAGE: Sendable {
+public struct FLO_SOURCE_TITLE: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
 
-  public var flags: Int32 = 0
+  public var title: String = String()
 
-  public var sourceUid: String = String()
+  /// Time the title was first seen, and the time it was changed
+  public var since: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _since ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_since = newValue}
+  }
+  /// Returns true if `since` has been explicitly set.
+  public var hasSince: Bool {return self._since != nil}
+  /// Clears the value of `since`. Subsequent reads from it will return its default value.
+  public mutating func clearSince() {self._since = nil}
+
+  public var until: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _until ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_until = newValue}
+  }
+  /// Returns true if `until` has been explicitly set.
+  public var hasUntil: Bool {return self._until != nil}
+  /// Clears the value of `until`. Subsequent reads from it will return its default value.
+  public mutating func clearUntil() {self._until = nil}
 
-  public var title: String = String()
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
 
-  public var messageUid: String = String()
+  public init() {}
+
+  fileprivate var _since: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+  fileprivate var _until: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+public struct FLO_MESSAGE: @unchecked Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 {
+    get {return _storage._flags}
+    set {_uniqueStorage()._flags = newValue}
+  }
+
+  public var sourceUid: String {
+    get {return _storage._sourceUid}
+    set {_uniqueStorage()._sourceUid = newValue}
+  }
+
+  public var title: String {
+    get {return _storage._title}
+    set {_uniqueStorage()._title = newValue}
+  }
+
+  public var messageUid: String {
+    get {return _storage._messageUid}
+    set {_uniqueStorage()._messageUid = newValue}
+  }
 
   public var createdAt: SwiftProtobuf.Google_Protobuf_Timestamp {
-    get {return _createdAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
-    set {_createdAt = newValue}
+    get {return _storage._createdAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_uniqueStorage()._createdAt = newValue}
   }
   /// Returns true if `createdAt` has been explicitly set.
-  public var hasCreatedAt: Bool {return self._createdAt != nil}
+  public var hasCreatedAt: Bool {return _storage._createdAt != nil}
   /// Clears the value of `createdAt`. Subsequent reads from it will return its default value.
-  public mutating func clearCreatedAt() {self._createdAt = nil}
+  public mutating func clearCreatedAt() {_uniqueStorage()._createdAt = nil}
 
-  public var text: String = String()
+  public var text: String {
+    get {return _storage._text}
+    set {_uniqueStorage()._text = newValue}
+  }
+
+  public var messageLinks: [String] {
+    get {return _storage._messageLinks}
+    set {_uniqueStorage()._messageLinks = newValue}
+  }
+
+  /// Origin of forwarded message (ForwardFromSource flag is set).
+  /// Origin not known to flo_tg may have no title, origin hidden by privacy settings has title only.
+  public var forwardFromSource: FLO_SOURCE {
+    get {return _storage._forwardFromSource ?? FLO_SOURCE()}
+    set {_uniqueStorage()._forwardFromSource = newValue}
+  }
+  /// Returns true if `forwardFromSource` has been explicitly set.
+  public var hasForwardFromSource: Bool {return _storage._forwardFromSource != nil}
+  /// Clears the value of `forwardFromSource`. Subsequent reads from it will return its default value.
+  public mutating func clearForwardFromSource() {_uniqueStorage()._forwardFromSource = nil}
+
+  /// Time of the last edit, unset if message was never edited
+  public var editedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _storage._editedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_uniqueStorage()._editedAt = newValue}
+  }
+  /// Returns true if `editedAt` has been explicitly set.
+  public var hasEditedAt: Bool {return _storage._editedAt != nil}
+  /// Clears the value of `editedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearEditedAt() {_uniqueStorage()._editedAt = nil}
+
+  /// All versions of the message, oldest first (including current). Only set on request.
+  public var revisions: [FLO_MESSAGE_REVISION] {
+    get {return _storage._revisions}
+    set {_uniqueStorage()._revisions = newValue}
+  }
+
+  /// Time the message was deleted in telegram (Deleted flag is set), message is kept in storage
+  public var deletedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _storage._deletedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_uniqueStorage()._deletedAt = newValue}
+  }
+  /// Returns true if `deletedAt` has been explicitly set.
+  public var hasDeletedAt: Bool {return _storage._deletedAt != nil}
+  /// Clears the value of `deletedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearDeletedAt() {_uniqueStorage()._deletedAt = nil}
+
+  /// Original message id and date of forwarded message, id is only known for channel posts
+  public var forwardFromMessageID: Int32 {
+    get {return _storage._forwardFromMessageID}
+    set {_uniqueStorage()._forwardFromMessageID = newValue}
+  }
+
+  public var forwardFromCreatedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _storage._forwardFromCreatedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_uniqueStorage()._forwardFromCreatedAt = newValue}
+  }
+  /// Returns true if `forwardFromCreatedAt` has been explicitly set.
+  public var hasForwardFromCreatedAt: Bool {return _storage._forwardFromCreatedAt != nil}
+  /// Clears the value of `forwardFromCreatedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearForwardFromCreatedAt() {_uniqueStorage()._forwardFromCreatedAt = nil}
+
+  /// Media of the message, files are downloaded in background (see GetAttachment)
+  public var attachments: [FLO_ATTACHMENT] {
+    get {return _storage._attachments}
+    set {_uniqueStorage()._attachments = newValue}
+  }
+
+  /// Formatting of text (bold, links, code, mentions, ...)
+  public var entities: [FLO_ENTITY] {
+    get {return _storage._entities}
+    set {_uniqueStorage()._entities = newValue}
+  }
+
+  /// Text rendered with entities, only set on request
+  public var textMarkdown: String {
+    get {return _storage._textMarkdown}
+    set {_uniqueStorage()._textMarkdown = newValue}
+  }
 
-  public var messageLinks: [String] = []
+  public var textHtml: String {
+    get {return _storage._textHtml}
+    set {_uniqueStorage()._textHtml = newValue}
+  }
+
+  /// Event of service message (Service flag is set), text is a short description of the event
+  public var serviceAction: FLO_SERVICE_ACTION {
+    get {return _storage._serviceAction ?? FLO_SERVICE_ACTION()}
+    set {_uniqueStorage()._serviceAction = newValue}
+  }
+  /// Returns true if `serviceAction` has been explicitly set.
+  public var hasServiceAction: Bool {return _storage._serviceAction != nil}
+  /// Clears the value of `serviceAction`. Subsequent reads from it will return its default value.
+  public mutating func clearServiceAction() {_uniqueStorage()._serviceAction = nil}
+
+  /// Replied message (may be of another source), and the first message of the reply thread
+  public var replyToMessageUid: String {
+    get {return _storage._replyToMessageUid}
+    set {_uniqueStorage()._replyToMessageUid = newValue}
+  }
+
+  public var replyToTopMessageUid: String {
+    get {return _storage._replyToTopMessageUid}
+    set {_uniqueStorage()._replyToTopMessageUid = newValue}
+  }
+
+  /// Forum topic (id of topic first message), zero when message is not in a topic or in General topic
+  public var topicID: Int32 {
+    get {return _storage._topicID}
+    set {_uniqueStorage()._topicID = newValue}
+  }
+
+  /// Sender in groups and private chats, signature of channel posts. Unset when neither is known.
+  public var author: FLO_AUTHOR {
+    get {return _storage._author ?? FLO_AUTHOR()}
+    set {_uniqueStorage()._author = newValue}
+  }
+  /// Returns true if `author` has been explicitly set.
+  public var hasAuthor: Bool {return _storage._author != nil}
+  /// Clears the value of `author`. Subsequent reads from it will return its default value.
+  public mutating func clearAuthor() {_uniqueStorage()._author = nil}
 
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 
-  fileprivate var _createdAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+  fileprivate var _storage = _StorageClass.defaultInstance
 }
 
-public struct FlotgGetSourcesRequest: Sendable {
+public struct FLO_AUTHOR: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Source uid of sender user, or of channel when message is sent on behalf of a channel
+  public var sourceUid: String = String()
+
+  /// Only set for user senders
+  public var userID: Int64 = 0
+
+  /// Name of user or title of channel, and public username (without "@") if any
+  public var displayName: String = String()
+
+  public var username: String = String()
+
+  /// Post author signature of signed channel posts
+  public var signature: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FLO_SERVICE_ACTION: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var type: FLO_SERVICE_ACTION_TYPE = .actionUnknown
+
+  /// New title of group, channel or topic
+  public var title: String = String()
+
+  /// Telegram ids of users added, joined, left or invited
+  public var userIDs: [Int64] = []
+
+  /// ActionPin: the pinned message
+  public var pinnedMessageUid: String = String()
+
+  /// ActionGroupCallEnd, ActionPhoneCall: duration in seconds
+  public var duration: Int32 = 0
+
+  /// ActionGroupCallScheduled: planned start of the call
+  public var scheduledAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _scheduledAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_scheduledAt = newValue}
+  }
+  /// Returns true if `scheduledAt` has been explicitly set.
+  public var hasScheduledAt: Bool {return self._scheduledAt != nil}
+  /// Clears the value of `scheduledAt`. Subsequent reads from it will return its default value.
+  public mutating func clearScheduledAt() {self._scheduledAt = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _scheduledAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+public struct FLO_ENTITY: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var type: FLO_ENTITY_TYPE = .entityUnknown
+
+  /// Position in text, in UTF-16 code units
+  public var offset: Int32 = 0
+
+  public var length: Int32 = 0
+
+  /// EntityTextUrl: link target
+  public var url: String = String()
+
+  /// EntityPre: programming language of code block
+  public var language: String = String()
+
+  /// EntityMentionName: mentioned user id
+  public var userID: Int64 = 0
+
+  /// EntityCustomEmoji: emoji document id
+  public var documentID: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FLO_ATTACHMENT: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
   // methods supported on all messages.
 
-  public var flags: Int32 = 0
+  /// Same media forwarded or posted again has the same uid
+  public var attachmentUid: String = String()
+
+  public var type: FLO_ATTACHMENT_TYPE = .attachmentUnknown
+
+  public var mimeType: String = String()
+
+  public var size: Int64 = 0
+
+  /// Only set for documents with a file name
+  public var fileName: String = String()
+
+  /// Only set for photos, videos and images
+  public var width: Int32 = 0
+
+  public var height: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FLO_MESSAGE_REVISION: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Unset for the original version
+  public var editedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _editedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_editedAt = newValue}
+  }
+  /// Returns true if `editedAt` has been explicitly set.
+  public var hasEditedAt: Bool {return self._editedAt != nil}
+  /// Clears the value of `editedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearEditedAt() {self._editedAt = nil}
+
+  public var text: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _editedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+/// Status of flo_tg components, also available with grpc.health.v1 service (component name as service name)
+/// Ready fails with Unavailable status when not ready, with this response in status details
+public struct FlotgReadyResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var ready: Bool = false
+
+  public var components: [FlotgComponentStatus] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FlotgComponentStatus: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var name: String = String()
+
+  public var serving: Bool = false
+
+  public var message: String = String()
+
+  public var updatedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _updatedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_updatedAt = newValue}
+  }
+  /// Returns true if `updatedAt` has been explicitly set.
+  public var hasUpdatedAt: Bool {return self._updatedAt != nil}
+  /// Clears the value of `updatedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearUpdatedAt() {self._updatedAt = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _updatedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+public struct FlotgSourceRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUid: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FlotgGetSourcesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUids: [String] = []
+
+  public var filterFlags: [Int32] = []
+
+  /// Opaque token from "next-page-token" trailer of the previous response
+  public var pageToken: String = String()
+
+  /// Maximum number of sources in a page, zero means server default.
+  public var pageSize: Int32 = 0
+
+  public var monitoredOnly: Bool = false
+
+  /// Fill title_history of sources
+  public var includeTitleHistory: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FlotgGetMessagesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUid: String = String()
+
+  public var filterFlags: [Int32] = []
+
+  /// Time range on message creation time: since is inclusive, before is exclusive. Unset means unbounded.
+  public var messagesSince: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _messagesSince ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_messagesSince = newValue}
+  }
+  /// Returns true if `messagesSince` has been explicitly set.
+  public var hasMessagesSince: Bool {return self._messagesSince != nil}
+  /// Clears the value of `messagesSince`. Subsequent reads from it will return its default value.
+  public mutating func clearMessagesSince() {self._messagesSince = nil}
+
+  public var messagesBefore: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _messagesBefore ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_messagesBefore = newValue}
+  }
+  /// Returns true if `messagesBefore` has been explicitly set.
+  public var hasMessagesBefore: Bool {return self._messagesBefore != nil}
+  /// Clears the value of `messagesBefore`. Subsequent reads from it will return its default value.
+  public mutating func clearMessagesBefore() {self._messagesBefore = nil}
+
+  /// Maximum number of messages returned, zero for no limit.
+  public var maxCount: Int32 = 0
+
+  public var sortOrder: FlotgSortOrder = .sortAscending
+
+  /// Opaque token from "next-page-token" trailer of the previous response
+  public var pageToken: String = String()
+
+  /// Fill revisions of edited messages
+  public var includeRevisions: Bool = false
+
+  /// Deleted messages are included by default
+  public var deleted: FlotgDeletedFilter = .deletedInclude
+
+  /// Fill text_markdown and text_html of messages
+  public var includeMarkdown: Bool = false
+
+  public var includeHtml: Bool = false
+
+  /// Only messages of this forum topic, zero for all messages. General topic is 1.
+  public var topicID: Int32 = 0
+
+  /// Only messages of this author (author source_uid), empty for all
+  public var authorUid: String = String()
+
+  /// Maximum number of messages in a page, "next-page-token" trailer is set when more messages follow.
+  /// Zero means all messages are returned (up to max_count) without a page token.
+  public var pageSize: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _messagesSince: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+  fileprivate var _messagesBefore: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+public struct FlotgStreamMessagesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  /// Sources to receive new messages from, empty for all monitored sources
+  public var sourceUids: [String] = []
+
+  /// When set, stored messages since this time are sent first, then new messages follow
+  public var replaySince: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _replaySince ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_replaySince = newValue}
+  }
+  /// Returns true if `replaySince` has been explicitly set.
+  public var hasReplaySince: Bool {return self._replaySince != nil}
+  /// Clears the value of `replaySince`. Subsequent reads from it will return its default value.
+  public mutating func clearReplaySince() {self._replaySince = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _replaySince: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+/// Backfill saves history of a monitored source, older than messages saved since flo_tg started.
+/// Progress is saved, so an interrupted backfill continues where it stopped.
+public struct FlotgBackfillRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUid: String = String()
+
+  /// Stop after this number of messages is processed, zero for the whole history
+  public var maxMessages: Int32 = 0
+
+  /// Start again from the newest message, ignoring saved progress
+  public var restart: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FlotgBackfillProgress: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUid: String = String()
+
+  public var processed: Int32 = 0
+
+  public var total: Int32 = 0
+
+  /// Backfill continues before this message id
+  public var offsetMessageID: Int64 = 0
+
+  public var done: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Dialogs of the telegram account, listed sources are saved so monitoring can be turned on
+public struct FlotgListDialogsRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var filterFlags: [Int32] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FlotgDialog: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var source: FLO_SOURCE {
+    get {return _source ?? FLO_SOURCE()}
+    set {_source = newValue}
+  }
+  /// Returns true if `source` has been explicitly set.
+  public var hasSource: Bool {return self._source != nil}
+  /// Clears the value of `source`. Subsequent reads from it will return its default value.
+  public mutating func clearSource() {self._source = nil}
+
+  public var unreadCount: Int32 = 0
+
+  public var lastMessageAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _lastMessageAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_lastMessageAt = newValue}
+  }
+  /// Returns true if `lastMessageAt` has been explicitly set.
+  public var hasLastMessageAt: Bool {return self._lastMessageAt != nil}
+  /// Clears the value of `lastMessageAt`. Subsequent reads from it will return its default value.
+  public mutating func clearLastMessageAt() {self._lastMessageAt = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _source: FLO_SOURCE? = nil
+  fileprivate var _lastMessageAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+public struct FlotgAttachmentRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var attachmentUid: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Attachment file is streamed in chunks, in order
+public struct FlotgAttachmentChunk: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// File size, same in all chunks
+  public var size: Int64 = 0
+
+  public var offset: Int64 = 0
+
+  public var data: Data = Data()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Thread of a stored message: ancestors (root first), the message, then replies (oldest first)
+public struct FlotgThreadRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUid: String = String()
+
+  public var messageUid: String = String()
+
+  /// Maximum number of replies, zero means server default
+  public var maxReplies: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Forum topic of a supergroup, listed with Telegram API
+public struct FlotgTopic: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var sourceUid: String = String()
+
+  public var topicID: Int32 = 0
+
+  public var title: String = String()
+
+  public var createdAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _createdAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_createdAt = newValue}
+  }
+  /// Returns true if `createdAt` has been explicitly set.
+  public var hasCreatedAt: Bool {return self._createdAt != nil}
+  /// Clears the value of `createdAt`. Subsequent reads from it will return its default value.
+  public mutating func clearCreatedAt() {self._createdAt = nil}
+
+  public var closed: Bool = false
+
+  public var pinned: Bool = false
+
+  public var hidden: Bool = false
+
+  public var topMessageUid: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _createdAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+public struct FlotgAuthRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  /// AuthBegin: optional, must be the same number as TG_PHONE
+  public var phone: String = String()
+
+  /// AuthSubmitCode: login code sent by Telegram
+  public var code: String = String()
+
+  /// AuthSubmitPassword: 2FA password
+  public var password: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FlotgAuthStatus: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var state: FlotgAuthState = .authUnknown
+
+  /// Error of the last failed login attempt
+  public var error: String = String()
+
+  public var rpcLoginEnabled: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FloRssFeed: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUid: String = String()
+
+  public var title: String = String()
+
+  public var rssUri: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct FloRssCreateRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var flags: Int32 = 0
+
+  public var sourceUid: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+// MARK: - Code below here is support for the SwiftProtobuf runtime.
+
+extension FLAGS: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "Invalid"),
+    1: .same(proto: "V1"),
+    2: .same(proto: "Tg"),
+    4: .same(proto: "User"),
+    8: .same(proto: "Group"),
+    16: .same(proto: "Channel"),
+    32: .same(proto: "ForwardFromSource"),
+    64: .same(proto: "TgUsername"),
+    256: .same(proto: "Deleted"),
+    512: .same(proto: "Service"),
+    1024: .same(proto: "Bot"),
+    2048: .same(proto: "Scam"),
+    4096: .same(proto: "Fake"),
+    8192: .same(proto: "Verified"),
+    16384: .same(proto: "Premium"),
+    32768: .same(proto: "Forum"),
+  ]
+}
+
+extension FLO_SERVICE_ACTION_TYPE: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "ActionUnknown"),
+    1: .same(proto: "ActionPin"),
+    2: .same(proto: "ActionTitleChange"),
+    3: .same(proto: "ActionPhotoChange"),
+    4: .same(proto: "ActionPhotoDelete"),
+    5: .same(proto: "ActionMembersAdd"),
+    6: .same(proto: "ActionMemberJoinByLink"),
+    7: .same(proto: "ActionMemberJoinByRequest"),
+    8: .same(proto: "ActionMemberLeave"),
+    9: .same(proto: "ActionGroupCallStart"),
+    10: .same(proto: "ActionGroupCallEnd"),
+    11: .same(proto: "ActionGroupCallScheduled"),
+    12: .same(proto: "ActionGroupCallInvite"),
+    13: .same(proto: "ActionCreate"),
+    14: .same(proto: "ActionMigrate"),
+    15: .same(proto: "ActionHistoryClear"),
+    16: .same(proto: "ActionPhoneCall"),
+    17: .same(proto: "ActionTopicCreate"),
+    18: .same(proto: "ActionTopicEdit"),
+  ]
+}
+
+extension FLO_ENTITY_TYPE: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "EntityUnknown"),
+    1: .same(proto: "EntityBold"),
+    2: .same(proto: "EntityItalic"),
+    3: .same(proto: "EntityUnderline"),
+    4: .same(proto: "EntityStrike"),
+    5: .same(proto: "EntitySpoiler"),
+    6: .same(proto: "EntityCode"),
+    7: .same(proto: "EntityPre"),
+    8: .same(proto: "EntityBlockquote"),
+    9: .same(proto: "EntityTextUrl"),
+    10: .same(proto: "EntityUrl"),
+    11: .same(proto: "EntityEmail"),
+    12: .same(proto: "EntityPhone"),
+    13: .same(proto: "EntityMention"),
+    14: .same(proto: "EntityMentionName"),
+    15: .same(proto: "EntityHashtag"),
+    16: .same(proto: "EntityCashtag"),
+    17: .same(proto: "EntityBotCommand"),
+    18: .same(proto: "EntityBankCard"),
+    19: .same(proto: "EntityCustomEmoji"),
+  ]
+}
+
+extension FLO_ATTACHMENT_TYPE: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "AttachmentUnknown"),
+    1: .same(proto: "AttachmentPhoto"),
+    2: .same(proto: "AttachmentDocument"),
+    3: .same(proto: "AttachmentVideo"),
+    4: .same(proto: "AttachmentVoice"),
+  ]
+}
+
+extension FlotgDeletedFilter: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "DeletedInclude"),
+    1: .same(proto: "DeletedExclude"),
+    2: .same(proto: "DeletedOnly"),
+  ]
+}
+
+extension FlotgAuthState: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "AuthUnknown"),
+    1: .same(proto: "AuthChecking"),
+    2: .same(proto: "AuthWaitingBegin"),
+    3: .same(proto: "AuthSendingCode"),
+    4: .same(proto: "AuthWaitingCode"),
+    5: .same(proto: "AuthWaitingPassword"),
+    6: .same(proto: "AuthSigningIn"),
+    7: .same(proto: "AuthAuthorized"),
+    8: .same(proto: "AuthFailed"),
+  ]
+}
+
+extension FlotgSortOrder: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "SortAscending"),
+    1: .same(proto: "SortDescending"),
+  ]
+}
+
+extension FLO_SOURCE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_SOURCE"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uid"),
+    3: .same(proto: "title"),
+    4: .same(proto: "monitored"),
+    5: .same(proto: "username"),
+    6: .standard(proto: "title_history"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.title) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.monitored) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.username) }()
+      case 6: try { try decoder.decodeRepeatedMessageField(value: &self.titleHistory) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.sourceUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
+    }
+    if !self.title.isEmpty {
+      try visitor.visitSingularStringField(value: self.title, fieldNumber: 3)
+    }
+    if self.monitored != false {
+      try visitor.visitSingularBoolField(value: self.monitored, fieldNumber: 4)
+    }
+    if !self.username.isEmpty {
+      try visitor.visitSingularStringField(value: self.username, fieldNumber: 5)
+    }
+    if !self.titleHistory.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.titleHistory, fieldNumber: 6)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_SOURCE, rhs: FLO_SOURCE) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.sourceUid != rhs.sourceUid {return false}
+    if lhs.title != rhs.title {return false}
+    if lhs.monitored != rhs.monitored {return false}
+    if lhs.username != rhs.username {return false}
+    if lhs.titleHistory != rhs.titleHistory {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FLO_SOURCE_TITLE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_SOURCE_TITLE"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "title"),
+    2: .same(proto: "since"),
+    3: .same(proto: "until"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.title) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._since) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._until) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if !self.title.isEmpty {
+      try visitor.visitSingularStringField(value: self.title, fieldNumber: 1)
+    }
+    try { if let v = self._since {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    try { if let v = self._until {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_SOURCE_TITLE, rhs: FLO_SOURCE_TITLE) -> Bool {
+    if lhs.title != rhs.title {return false}
+    if lhs._since != rhs._since {return false}
+    if lhs._until != rhs._until {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FLO_MESSAGE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_MESSAGE"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uid"),
+    3: .same(proto: "title"),
+    5: .standard(proto: "message_uid"),
+    6: .standard(proto: "created_at"),
+    7: .same(proto: "text"),
+    8: .standard(proto: "message_links"),
+    9: .standard(proto: "forward_from_source"),
+    10: .standard(proto: "edited_at"),
+    11: .same(proto: "revisions"),
+    12: .standard(proto: "deleted_at"),
+    13: .standard(proto: "forward_from_message_id"),
+    14: .standard(proto: "forward_from_created_at"),
+    15: .same(proto: "attachments"),
+    16: .same(proto: "entities"),
+    17: .standard(proto: "text_markdown"),
+    18: .standard(proto: "text_html"),
+    19: .standard(proto: "service_action"),
+    20: .standard(proto: "reply_to_message_uid"),
+    21: .standard(proto: "reply_to_top_message_uid"),
+    22: .standard(proto: "topic_id"),
+    23: .same(proto: "author"),
+  ]
+
+  fileprivate class _StorageClass {
+    var _flags: Int32 = 0
+    var _sourceUid: String = String()
+    var _title: String = String()
+    var _messageUid: String = String()
+    var _createdAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+    var _text: String = String()
+    var _messageLinks: [String] = []
+    var _forwardFromSource: FLO_SOURCE? = nil
+    var _editedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+    var _revisions: [FLO_MESSAGE_REVISION] = []
+    var _deletedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+    var _forwardFromMessageID: Int32 = 0
+    var _forwardFromCreatedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+    var _attachments: [FLO_ATTACHMENT] = []
+    var _entities: [FLO_ENTITY] = []
+    var _textMarkdown: String = String()
+    var _textHtml: String = String()
+    var _serviceAction: FLO_SERVICE_ACTION? = nil
+    var _replyToMessageUid: String = String()
+    var _replyToTopMessageUid: String = String()
+    var _topicID: Int32 = 0
+    var _author: FLO_AUTHOR? = nil
+
+    #if swift(>=5.10)
+      // This property is used as the initial default value for new instances of the type.
+      // The type itself is protecting the reference to its storage via CoW semantics.
+      // This will force a copy to be made of this reference when the first mutation occurs;
+      // hence, it is safe to mark this as `nonisolated(unsafe)`.
+      static nonisolated(unsafe) let defaultInstance = _StorageClass()
+    #else
+      static let defaultInstance = _StorageClass()
+    #endif
+
+    private init() {}
+
+    init(copying source: _StorageClass) {
+      _flags = source._flags
+      _sourceUid = source._sourceUid
+      _title = source._title
+      _messageUid = source._messageUid
+      _createdAt = source._createdAt
+      _text = source._text
+      _messageLinks = source._messageLinks
+      _forwardFromSource = source._forwardFromSource
+      _editedAt = source._editedAt
+      _revisions = source._revisions
+      _deletedAt = source._deletedAt
+      _forwardFromMessageID = source._forwardFromMessageID
+      _forwardFromCreatedAt = source._forwardFromCreatedAt
+      _attachments = source._attachments
+      _entities = source._entities
+      _textMarkdown = source._textMarkdown
+      _textHtml = source._textHtml
+      _serviceAction = source._serviceAction
+      _replyToMessageUid = source._replyToMessageUid
+      _replyToTopMessageUid = source._replyToTopMessageUid
+      _topicID = source._topicID
+      _author = source._author
+    }
+  }
+
+  fileprivate mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _StorageClass(copying: _storage)
+    }
+    return _storage
+  }
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    _ = _uniqueStorage()
+    try withExtendedLifetime(_storage) { (_storage: _StorageClass) in
+      while let fieldNumber = try decoder.nextFieldNumber() {
+        // The use of inline closures is to circumvent an issue where the compiler
+        // allocates stack space for every case branch when no optimizations are
+        // enabled. https://github.com/apple/swift-protobuf/issues/1034
+        switch fieldNumber {
+        case 1: try { try decoder.decodeSingularInt32Field(value: &_storage._flags) }()
+        case 2: try { try decoder.decodeSingularStringField(value: &_storage._sourceUid) }()
+        case 3: try { try decoder.decodeSingularStringField(value: &_storage._title) }()
+        case 5: try { try decoder.decodeSingularStringField(value: &_storage._messageUid) }()
+        case 6: try { try decoder.decodeSingularMessageField(value: &_storage._createdAt) }()
+        case 7: try { try decoder.decodeSingularStringField(value: &_storage._text) }()
+        case 8: try { try decoder.decodeRepeatedStringField(value: &_storage._messageLinks) }()
+        case 9: try { try decoder.decodeSingularMessageField(value: &_storage._forwardFromSource) }()
+        case 10: try { try decoder.decodeSingularMessageField(value: &_storage._editedAt) }()
+        case 11: try { try decoder.decodeRepeatedMessageField(value: &_storage._revisions) }()
+        case 12: try { try decoder.decodeSingularMessageField(value: &_storage._deletedAt) }()
+        case 13: try { try decoder.decodeSingularInt32Field(value: &_storage._forwardFromMessageID) }()
+        case 14: try { try decoder.decodeSingularMessageField(value: &_storage._forwardFromCreatedAt) }()
+        case 15: try { try decoder.decodeRepeatedMessageField(value: &_storage._attachments) }()
+        case 16: try { try decoder.decodeRepeatedMessageField(value: &_storage._entities) }()
+        case 17: try { try decoder.decodeSingularStringField(value: &_storage._textMarkdown) }()
+        case 18: try { try decoder.decodeSingularStringField(value: &_storage._textHtml) }()
+        case 19: try { try decoder.decodeSingularMessageField(value: &_storage._serviceAction) }()
+        case 20: try { try decoder.decodeSingularStringField(value: &_storage._replyToMessageUid) }()
+        case 21: try { try decoder.decodeSingularStringField(value: &_storage._replyToTopMessageUid) }()
+        case 22: try { try decoder.decodeSingularInt32Field(value: &_storage._topicID) }()
+        case 23: try { try decoder.decodeSingularMessageField(value: &_storage._author) }()
+        default: break
+        }
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    try withExtendedLifetime(_storage) { (_storage: _StorageClass) in
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every if/case branch local when no optimizations
+      // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+      // https://github.com/apple/swift-protobuf/issues/1182
+      if _storage._flags != 0 {
+        try visitor.visitSingularInt32Field(value: _storage._flags, fieldNumber: 1)
+      }
+      if !_storage._sourceUid.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._sourceUid, fieldNumber: 2)
+      }
+      if !_storage._title.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._title, fieldNumber: 3)
+      }
+      if !_storage._messageUid.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._messageUid, fieldNumber: 5)
+      }
+      try { if let v = _storage._createdAt {
+        try visitor.visitSingularMessageField(value: v, fieldNumber: 6)
+      } }()
+      if !_storage._text.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._text, fieldNumber: 7)
+      }
+      if !_storage._messageLinks.isEmpty {
+        try visitor.visitRepeatedStringField(value: _storage._messageLinks, fieldNumber: 8)
+      }
+      try { if let v = _storage._forwardFromSource {
+        try visitor.visitSingularMessageField(value: v, fieldNumber: 9)
+      } }()
+      try { if let v = _storage._editedAt {
+        try visitor.visitSingularMessageField(value: v, fieldNumber: 10)
+      } }()
+      if !_storage._revisions.isEmpty {
+        try visitor.visitRepeatedMessageField(value: _storage._revisions, fieldNumber: 11)
+      }
+      try { if let v = _storage._deletedAt {
+        try visitor.visitSingularMessageField(value: v, fieldNumber: 12)
+      } }()
+      if _storage._forwardFromMessageID != 0 {
+        try visitor.visitSingularInt32Field(value: _storage._forwardFromMessageID, fieldNumber: 13)
+      }
+      try { if let v = _storage._forwardFromCreatedAt {
+        try visitor.visitSingularMessageField(value: v, fieldNumber: 14)
+      } }()
+      if !_storage._attachments.isEmpty {
+        try visitor.visitRepeatedMessageField(value: _storage._attachments, fieldNumber: 15)
+      }
+      if !_storage._entities.isEmpty {
+        try visitor.visitRepeatedMessageField(value: _storage._entities, fieldNumber: 16)
+      }
+      if !_storage._textMarkdown.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._textMarkdown, fieldNumber: 17)
+      }
+      if !_storage._textHtml.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._textHtml, fieldNumber: 18)
+      }
+      try { if let v = _storage._serviceAction {
+        try visitor.visitSingularMessageField(value: v, fieldNumber: 19)
+      } }()
+      if !_storage._replyToMessageUid.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._replyToMessageUid, fieldNumber: 20)
+      }
+      if !_storage._replyToTopMessageUid.isEmpty {
+        try visitor.visitSingularStringField(value: _storage._replyToTopMessageUid, fieldNumber: 21)
+      }
+      if _storage._topicID != 0 {
+        try visitor.visitSingularInt32Field(value: _storage._topicID, fieldNumber: 22)
+      }
+      try { if let v = _storage._author {
+        try visitor.visitSingularMessageField(value: v, fieldNumber: 23)
+      } }()
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_MESSAGE, rhs: FLO_MESSAGE) -> Bool {
+    if lhs._storage !== rhs._storage {
+      let storagesAreEqual: Bool = withExtendedLifetime((lhs._storage, rhs._storage)) { (_args: (_StorageClass, _StorageClass)) in
+        let _storage = _args.0
+        let rhs_storage = _args.1
+        if _storage._flags != rhs_storage._flags {return false}
+        if _storage._sourceUid != rhs_storage._sourceUid {return false}
+        if _storage._title != rhs_storage._title {return false}
+        if _storage._messageUid != rhs_storage._messageUid {return false}
+        if _storage._createdAt != rhs_storage._createdAt {return false}
+        if _storage._text != rhs_storage._text {return false}
+        if _storage._messageLinks != rhs_storage._messageLinks {return false}
+        if _storage._forwardFromSource != rhs_storage._forwardFromSource {return false}
+        if _storage._editedAt != rhs_storage._editedAt {return false}
+        if _storage._revisions != rhs_storage._revisions {return false}
+        if _storage._deletedAt != rhs_storage._deletedAt {return false}
+        if _storage._forwardFromMessageID != rhs_storage._forwardFromMessageID {return false}
+        if _storage._forwardFromCreatedAt != rhs_storage._forwardFromCreatedAt {return false}
+        if _storage._attachments != rhs_storage._attachments {return false}
+        if _storage._entities != rhs_storage._entities {return false}
+        if _storage._textMarkdown != rhs_storage._textMarkdown {return false}
+        if _storage._textHtml != rhs_storage._textHtml {return false}
+        if _storage._serviceAction != rhs_storage._serviceAction {return false}
+        if _storage._replyToMessageUid != rhs_storage._replyToMessageUid {return false}
+        if _storage._replyToTopMessageUid != rhs_storage._replyToTopMessageUid {return false}
+        if _storage._topicID != rhs_storage._topicID {return false}
+        if _storage._author != rhs_storage._author {return false}
+        return true
+      }
+      if !storagesAreEqual {return false}
+    }
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FLO_AUTHOR: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_AUTHOR"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .standard(proto: "source_uid"),
+    2: .standard(proto: "user_id"),
+    3: .standard(proto: "display_name"),
+    4: .same(proto: "username"),
+    5: .same(proto: "signature"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.userID) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.displayName) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.username) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.signature) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.sourceUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 1)
+    }
+    if self.userID != 0 {
+      try visitor.visitSingularInt64Field(value: self.userID, fieldNumber: 2)
+    }
+    if !self.displayName.isEmpty {
+      try visitor.visitSingularStringField(value: self.displayName, fieldNumber: 3)
+    }
+    if !self.username.isEmpty {
+      try visitor.visitSingularStringField(value: self.username, fieldNumber: 4)
+    }
+    if !self.signature.isEmpty {
+      try visitor.visitSingularStringField(value: self.signature, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_AUTHOR, rhs: FLO_AUTHOR) -> Bool {
+    if lhs.sourceUid != rhs.sourceUid {return false}
+    if lhs.userID != rhs.userID {return false}
+    if lhs.displayName != rhs.displayName {return false}
+    if lhs.username != rhs.username {return false}
+    if lhs.signature != rhs.signature {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FLO_SERVICE_ACTION: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_SERVICE_ACTION"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "type"),
+    2: .same(proto: "title"),
+    3: .standard(proto: "user_ids"),
+    4: .standard(proto: "pinned_message_uid"),
+    5: .same(proto: "duration"),
+    6: .standard(proto: "scheduled_at"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularEnumField(value: &self.type) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.title) }()
+      case 3: try { try decoder.decodeRepeatedInt64Field(value: &self.userIDs) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.pinnedMessageUid) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.duration) }()
+      case 6: try { try decoder.decodeSingularMessageField(value: &self._scheduledAt) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.type != .actionUnknown {
+      try visitor.visitSingularEnumField(value: self.type, fieldNumber: 1)
+    }
+    if !self.title.isEmpty {
+      try visitor.visitSingularStringField(value: self.title, fieldNumber: 2)
+    }
+    if !self.userIDs.isEmpty {
+      try visitor.visitPackedInt64Field(value: self.userIDs, fieldNumber: 3)
+    }
+    if !self.pinnedMessageUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.pinnedMessageUid, fieldNumber: 4)
+    }
+    if self.duration != 0 {
+      try visitor.visitSingularInt32Field(value: self.duration, fieldNumber: 5)
+    }
+    try { if let v = self._scheduledAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 6)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_SERVICE_ACTION, rhs: FLO_SERVICE_ACTION) -> Bool {
+    if lhs.type != rhs.type {return false}
+    if lhs.title != rhs.title {return false}
+    if lhs.userIDs != rhs.userIDs {return false}
+    if lhs.pinnedMessageUid != rhs.pinnedMessageUid {return false}
+    if lhs.duration != rhs.duration {return false}
+    if lhs._scheduledAt != rhs._scheduledAt {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FLO_ENTITY: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_ENTITY"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "type"),
+    2: .same(proto: "offset"),
+    3: .same(proto: "length"),
+    4: .same(proto: "url"),
+    5: .same(proto: "language"),
+    6: .standard(proto: "user_id"),
+    7: .standard(proto: "document_id"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularEnumField(value: &self.type) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.offset) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.length) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.url) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.language) }()
+      case 6: try { try decoder.decodeSingularInt64Field(value: &self.userID) }()
+      case 7: try { try decoder.decodeSingularInt64Field(value: &self.documentID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.type != .entityUnknown {
+      try visitor.visitSingularEnumField(value: self.type, fieldNumber: 1)
+    }
+    if self.offset != 0 {
+      try visitor.visitSingularInt32Field(value: self.offset, fieldNumber: 2)
+    }
+    if self.length != 0 {
+      try visitor.visitSingularInt32Field(value: self.length, fieldNumber: 3)
+    }
+    if !self.url.isEmpty {
+      try visitor.visitSingularStringField(value: self.url, fieldNumber: 4)
+    }
+    if !self.language.isEmpty {
+      try visitor.visitSingularStringField(value: self.language, fieldNumber: 5)
+    }
+    if self.userID != 0 {
+      try visitor.visitSingularInt64Field(value: self.userID, fieldNumber: 6)
+    }
+    if self.documentID != 0 {
+      try visitor.visitSingularInt64Field(value: self.documentID, fieldNumber: 7)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_ENTITY, rhs: FLO_ENTITY) -> Bool {
+    if lhs.type != rhs.type {return false}
+    if lhs.offset != rhs.offset {return false}
+    if lhs.length != rhs.length {return false}
+    if lhs.url != rhs.url {return false}
+    if lhs.language != rhs.language {return false}
+    if lhs.userID != rhs.userID {return false}
+    if lhs.documentID != rhs.documentID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FLO_ATTACHMENT: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_ATTACHMENT"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .standard(proto: "attachment_uid"),
+    2: .same(proto: "type"),
+    3: .standard(proto: "mime_type"),
+    4: .same(proto: "size"),
+    5: .standard(proto: "file_name"),
+    6: .same(proto: "width"),
+    7: .same(proto: "height"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.attachmentUid) }()
+      case 2: try { try decoder.decodeSingularEnumField(value: &self.type) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.mimeType) }()
+      case 4: try { try decoder.decodeSingularInt64Field(value: &self.size) }()
+      case 5: try { try decoder.decodeSingularStringField(value: &self.fileName) }()
+      case 6: try { try decoder.decodeSingularInt32Field(value: &self.width) }()
+      case 7: try { try decoder.decodeSingularInt32Field(value: &self.height) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.attachmentUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.attachmentUid, fieldNumber: 1)
+    }
+    if self.type != .attachmentUnknown {
+      try visitor.visitSingularEnumField(value: self.type, fieldNumber: 2)
+    }
+    if !self.mimeType.isEmpty {
+      try visitor.visitSingularStringField(value: self.mimeType, fieldNumber: 3)
+    }
+    if self.size != 0 {
+      try visitor.visitSingularInt64Field(value: self.size, fieldNumber: 4)
+    }
+    if !self.fileName.isEmpty {
+      try visitor.visitSingularStringField(value: self.fileName, fieldNumber: 5)
+    }
+    if self.width != 0 {
+      try visitor.visitSingularInt32Field(value: self.width, fieldNumber: 6)
+    }
+    if self.height != 0 {
+      try visitor.visitSingularInt32Field(value: self.height, fieldNumber: 7)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_ATTACHMENT, rhs: FLO_ATTACHMENT) -> Bool {
+    if lhs.attachmentUid != rhs.attachmentUid {return false}
+    if lhs.type != rhs.type {return false}
+    if lhs.mimeType != rhs.mimeType {return false}
+    if lhs.size != rhs.size {return false}
+    if lhs.fileName != rhs.fileName {return false}
+    if lhs.width != rhs.width {return false}
+    if lhs.height != rhs.height {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FLO_MESSAGE_REVISION: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FLO_MESSAGE_REVISION"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .standard(proto: "edited_at"),
+    2: .same(proto: "text"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._editedAt) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.text) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._editedAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if !self.text.isEmpty {
+      try visitor.visitSingularStringField(value: self.text, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FLO_MESSAGE_REVISION, rhs: FLO_MESSAGE_REVISION) -> Bool {
+    if lhs._editedAt != rhs._editedAt {return false}
+    if lhs.text != rhs.text {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FlotgReadyResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgReadyResponse"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "ready"),
+    2: .same(proto: "components"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularBoolField(value: &self.ready) }()
+      case 2: try { try decoder.decodeRepeatedMessageField(value: &self.components) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.ready != false {
+      try visitor.visitSingularBoolField(value: self.ready, fieldNumber: 1)
+    }
+    if !self.components.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.components, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgReadyResponse, rhs: FlotgReadyResponse) -> Bool {
+    if lhs.ready != rhs.ready {return false}
+    if lhs.components != rhs.components {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FlotgComponentStatus: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgComponentStatus"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "name"),
+    2: .same(proto: "serving"),
+    3: .same(proto: "message"),
+    4: .standard(proto: "updated_at"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.name) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.serving) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.message) }()
+      case 4: try { try decoder.decodeSingularMessageField(value: &self._updatedAt) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if !self.name.isEmpty {
+      try visitor.visitSingularStringField(value: self.name, fieldNumber: 1)
+    }
+    if self.serving != false {
+      try visitor.visitSingularBoolField(value: self.serving, fieldNumber: 2)
+    }
+    if !self.message.isEmpty {
+      try visitor.visitSingularStringField(value: self.message, fieldNumber: 3)
+    }
+    try { if let v = self._updatedAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 4)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgComponentStatus, rhs: FlotgComponentStatus) -> Bool {
+    if lhs.name != rhs.name {return false}
+    if lhs.serving != rhs.serving {return false}
+    if lhs.message != rhs.message {return false}
+    if lhs._updatedAt != rhs._updatedAt {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FlotgSourceRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgSourceRequest"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uid"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.sourceUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgSourceRequest, rhs: FlotgSourceRequest) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.sourceUid != rhs.sourceUid {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FlotgGetSourcesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgGetSourcesRequest"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uids"),
+    3: .standard(proto: "filter_flags"),
+    4: .standard(proto: "page_token"),
+    5: .standard(proto: "page_size"),
+    6: .standard(proto: "monitored_only"),
+    7: .standard(proto: "include_title_history"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeRepeatedStringField(value: &self.sourceUids) }()
+      case 3: try { try decoder.decodeRepeatedInt32Field(value: &self.filterFlags) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.pageToken) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.pageSize) }()
+      case 6: try { try decoder.decodeSingularBoolField(value: &self.monitoredOnly) }()
+      case 7: try { try decoder.decodeSingularBoolField(value: &self.includeTitleHistory) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.sourceUids.isEmpty {
+      try visitor.visitRepeatedStringField(value: self.sourceUids, fieldNumber: 2)
+    }
+    if !self.filterFlags.isEmpty {
+      try visitor.visitPackedInt32Field(value: self.filterFlags, fieldNumber: 3)
+    }
+    if !self.pageToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.pageToken, fieldNumber: 4)
+    }
+    if self.pageSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.pageSize, fieldNumber: 5)
+    }
+    if self.monitoredOnly != false {
+      try visitor.visitSingularBoolField(value: self.monitoredOnly, fieldNumber: 6)
+    }
+    if self.includeTitleHistory != false {
+      try visitor.visitSingularBoolField(value: self.includeTitleHistory, fieldNumber: 7)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgGetSourcesRequest, rhs: FlotgGetSourcesRequest) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.sourceUids != rhs.sourceUids {return false}
+    if lhs.filterFlags != rhs.filterFlags {return false}
+    if lhs.pageToken != rhs.pageToken {return false}
+    if lhs.pageSize != rhs.pageSize {return false}
+    if lhs.monitoredOnly != rhs.monitoredOnly {return false}
+    if lhs.includeTitleHistory != rhs.includeTitleHistory {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FlotgGetMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgGetMessagesRequest"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uid"),
+    3: .standard(proto: "filter_flags"),
+    4: .standard(proto: "messages_since"),
+    5: .standard(proto: "messages_before"),
+    6: .standard(proto: "max_count"),
+    7: .standard(proto: "sort_order"),
+    8: .standard(proto: "page_token"),
+    9: .standard(proto: "include_revisions"),
+    10: .same(proto: "deleted"),
+    11: .standard(proto: "include_markdown"),
+    12: .standard(proto: "include_html"),
+    13: .standard(proto: "topic_id"),
+    14: .standard(proto: "author_uid"),
+    15: .standard(proto: "page_size"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      case 3: try { try decoder.decodeRepeatedInt32Field(value: &self.filterFlags) }()
+      case 4: try { try decoder.decodeSingularMessageField(value: &self._messagesSince) }()
+      case 5: try { try decoder.decodeSingularMessageField(value: &self._messagesBefore) }()
+      case 6: try { try decoder.decodeSingularInt32Field(value: &self.maxCount) }()
+      case 7: try { try decoder.decodeSingularEnumField(value: &self.sortOrder) }()
+      case 8: try { try decoder.decodeSingularStringField(value: &self.pageToken) }()
+      case 9: try { try decoder.decodeSingularBoolField(value: &self.includeRevisions) }()
+      case 10: try { try decoder.decodeSingularEnumField(value: &self.deleted) }()
+      case 11: try { try decoder.decodeSingularBoolField(value: &self.includeMarkdown) }()
+      case 12: try { try decoder.decodeSingularBoolField(value: &self.includeHtml) }()
+      case 13: try { try decoder.decodeSingularInt32Field(value: &self.topicID) }()
+      case 14: try { try decoder.decodeSingularStringField(value: &self.authorUid) }()
+      case 15: try { try decoder.decodeSingularInt32Field(value: &self.pageSize) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.sourceUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
+    }
+    if !self.filterFlags.isEmpty {
+      try visitor.visitPackedInt32Field(value: self.filterFlags, fieldNumber: 3)
+    }
+    try { if let v = self._messagesSince {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 4)
+    } }()
+    try { if let v = self._messagesBefore {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 5)
+    } }()
+    if self.maxCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxCount, fieldNumber: 6)
+    }
+    if self.sortOrder != .sortAscending {
+      try visitor.visitSingularEnumField(value: self.sortOrder, fieldNumber: 7)
+    }
+    if !self.pageToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.pageToken, fieldNumber: 8)
+    }
+    if self.includeRevisions != false {
+      try visitor.visitSingularBoolField(value: self.includeRevisions, fieldNumber: 9)
+    }
+    if self.deleted != .deletedInclude {
+      try visitor.visitSingularEnumField(value: self.deleted, fieldNumber: 10)
+    }
+    if self.includeMarkdown != false {
+      try visitor.visitSingularBoolField(value: self.includeMarkdown, fieldNumber: 11)
+    }
+    if self.includeHtml != false {
+      try visitor.visitSingularBoolField(value: self.includeHtml, fieldNumber: 12)
+    }
+    if self.topicID != 0 {
+      try visitor.visitSingularInt32Field(value: self.topicID, fieldNumber: 13)
+    }
+    if !self.authorUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.authorUid, fieldNumber: 14)
+    }
+    if self.pageSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.pageSize, fieldNumber: 15)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgGetMessagesRequest, rhs: FlotgGetMessagesRequest) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.sourceUid != rhs.sourceUid {return false}
+    if lhs.filterFlags != rhs.filterFlags {return false}
+    if lhs._messagesSince != rhs._messagesSince {return false}
+    if lhs._messagesBefore != rhs._messagesBefore {return false}
+    if lhs.maxCount != rhs.maxCount {return false}
+    if lhs.sortOrder != rhs.sortOrder {return false}
+    if lhs.pageToken != rhs.pageToken {return false}
+    if lhs.includeRevisions != rhs.includeRevisions {return false}
+    if lhs.deleted != rhs.deleted {return false}
+    if lhs.includeMarkdown != rhs.includeMarkdown {return false}
+    if lhs.includeHtml != rhs.includeHtml {return false}
+    if lhs.topicID != rhs.topicID {return false}
+    if lhs.authorUid != rhs.authorUid {return false}
+    if lhs.pageSize != rhs.pageSize {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FlotgStreamMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgStreamMessagesRequest"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uids"),
+    3: .standard(proto: "replay_since"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeRepeatedStringField(value: &self.sourceUids) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._replaySince) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.sourceUids.isEmpty {
+      try visitor.visitRepeatedStringField(value: self.sourceUids, fieldNumber: 2)
+    }
+    try { if let v = self._replaySince {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgStreamMessagesRequest, rhs: FlotgStreamMessagesRequest) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.sourceUids != rhs.sourceUids {return false}
+    if lhs._replaySince != rhs._replaySince {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension FlotgBackfillRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgBackfillRequest"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uid"),
+    3: .standard(proto: "max_messages"),
+    4: .same(proto: "restart"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.maxMessages) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.restart) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.sourceUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
+    }
+    if self.maxMessages != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxMessages, fieldNumber: 3)
+    }
+    if self.restart != false {
+      try visitor.visitSingularBoolField(value: self.restart, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgBackfillRequest, rhs: FlotgBackfillRequest) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.sourceUid != rhs.sourceUid {return false}
+    if lhs.maxMessages != rhs.maxMessages {return false}
+    if lhs.restart != rhs.restart {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
 
-  public var sourceUids: [String] = []
+extension FlotgBackfillProgress: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgBackfillProgress"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "source_uid"),
+    3: .same(proto: "processed"),
+    4: .same(proto: "total"),
+    5: .standard(proto: "offset_message_id"),
+    6: .same(proto: "done"),
+  ]
 
-  public var filterFlags: [Int32] = []
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.processed) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.total) }()
+      case 5: try { try decoder.decodeSingularInt64Field(value: &self.offsetMessageID) }()
+      case 6: try { try decoder.decodeSingularBoolField(value: &self.done) }()
+      default: break
+      }
+    }
+  }
 
-  public var unknownFields = SwiftProtobuf.UnknownStorage()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.sourceUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
+    }
+    if self.processed != 0 {
+      try visitor.visitSingularInt32Field(value: self.processed, fieldNumber: 3)
+    }
+    if self.total != 0 {
+      try visitor.visitSingularInt32Field(value: self.total, fieldNumber: 4)
+    }
+    if self.offsetMessageID != 0 {
+      try visitor.visitSingularInt64Field(value: self.offsetMessageID, fieldNumber: 5)
+    }
+    if self.done != false {
+      try visitor.visitSingularBoolField(value: self.done, fieldNumber: 6)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public init() {}
+  public static func ==(lhs: FlotgBackfillProgress, rhs: FlotgBackfillProgress) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.sourceUid != rhs.sourceUid {return false}
+    if lhs.processed != rhs.processed {return false}
+    if lhs.total != rhs.total {return false}
+    if lhs.offsetMessageID != rhs.offsetMessageID {return false}
+    if lhs.done != rhs.done {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-public struct FlotgGetMessagesRequest: Sendable {
-  // SwiftProtobuf.Message conformance is added in an extension below. See the
-  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
-  // methods supported on all messages.
-
-  public var flags: Int32 = 0
-
-  public var sourceUid: String = String()
+extension FlotgListDialogsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgListDialogsRequest"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "filter_flags"),
+  ]
 
-  ///optional google.protobuf.Timestamp messages_since =
-  ///optional google.protobuf.Timestamp messages_before =
-  public var filterFlags: [Int32] = []
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeRepeatedInt32Field(value: &self.filterFlags) }()
+      default: break
+      }
+    }
+  }
 
-  public var unknownFields = SwiftProtobuf.UnknownStorage()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.filterFlags.isEmpty {
+      try visitor.visitPackedInt32Field(value: self.filterFlags, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public init() {}
+  public static func ==(lhs: FlotgListDialogsRequest, rhs: FlotgListDialogsRequest) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.filterFlags != rhs.filterFlags {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-public struct FloRssFeed: Sendable {
-  // SwiftProtobuf.Message conformance is added in an extension below. See the
-  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
-  // methods supported on all messages.
-
-  public var flags: Int32 = 0
-
-  public var sourceUid: String = String()
-
-  public var title: String = String()
+extension FlotgDialog: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgDialog"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "source"),
+    2: .standard(proto: "unread_count"),
+    3: .standard(proto: "last_message_at"),
+  ]
 
-  public var rssUri: String = String()
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._source) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.unreadCount) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._lastMessageAt) }()
+      default: break
+      }
+    }
+  }
 
-  public var unknownFields = SwiftProtobuf.UnknownStorage()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._source {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.unreadCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.unreadCount, fieldNumber: 2)
+    }
+    try { if let v = self._lastMessageAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public init() {}
+  public static func ==(lhs: FlotgDialog, rhs: FlotgDialog) -> Bool {
+    if lhs._source != rhs._source {return false}
+    if lhs.unreadCount != rhs.unreadCount {return false}
+    if lhs._lastMessageAt != rhs._lastMessageAt {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-public struct FloRssCreateRequest: Sendable {
-  // SwiftProtobuf.Message conformance is added in an extension below. See the
-  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
-  // methods supported on all messages.
-
-  public var flags: Int32 = 0
+extension FlotgAttachmentRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgAttachmentRequest"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "flags"),
+    2: .standard(proto: "attachment_uid"),
+  ]
 
-  public var sourceUid: String = String()
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.attachmentUid) }()
+      default: break
+      }
+    }
+  }
 
-  public var unknownFields = SwiftProtobuf.UnknownStorage()
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.flags != 0 {
+      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    }
+    if !self.attachmentUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.attachmentUid, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
 
-  public init() {}
+  public static func ==(lhs: FlotgAttachmentRequest, rhs: FlotgAttachmentRequest) -> Bool {
+    if lhs.flags != rhs.flags {return false}
+    if lhs.attachmentUid != rhs.attachmentUid {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-// MARK: - Code below here is support for the SwiftProtobuf runtime.
-
-extension FLAGS: SwiftProtobuf._ProtoNameProviding {
+extension FlotgAttachmentChunk: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgAttachmentChunk"
   public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
-    0: .same(proto: "Invalid"),
-    1: .same(proto: "V1"),
-    2: .same(proto: "Tg"),
-    4: .same(proto: "User"),
-    8: .same(proto: "Group"),
-    16: .same(proto: "Channel"),
+    1: .same(proto: "size"),
+    2: .same(proto: "offset"),
+    3: .same(proto: "data"),
   ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt64Field(value: &self.size) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.offset) }()
+      case 3: try { try decoder.decodeSingularBytesField(value: &self.data) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.size != 0 {
+      try visitor.visitSingularInt64Field(value: self.size, fieldNumber: 1)
+    }
+    if self.offset != 0 {
+      try visitor.visitSingularInt64Field(value: self.offset, fieldNumber: 2)
+    }
+    if !self.data.isEmpty {
+      try visitor.visitSingularBytesField(value: self.data, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: FlotgAttachmentChunk, rhs: FlotgAttachmentChunk) -> Bool {
+    if lhs.size != rhs.size {return false}
+    if lhs.offset != rhs.offset {return false}
+    if lhs.data != rhs.data {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
 }
 
-extension FLO_SOURCE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = "FLO_SOURCE"
+extension FlotgThreadRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgThreadRequest"
   public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
     1: .same(proto: "flags"),
     2: .standard(proto: "source_uid"),
-    3: .same(proto: "title"),
+    3: .standard(proto: "message_uid"),
+    4: .standard(proto: "max_replies"),
   ]
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
@@ -214,7 +2729,8 @@ extension FLO_SOURCE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementatio
       switch fieldNumber {
       case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
       case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
-      case 3: try { try decoder.decodeSingularStringField(value: &self.title) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.messageUid) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.maxReplies) }()
       default: break
       }
     }
@@ -227,31 +2743,36 @@ extension FLO_SOURCE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementatio
     if !self.sourceUid.isEmpty {
       try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
     }
-    if !self.title.isEmpty {
-      try visitor.visitSingularStringField(value: self.title, fieldNumber: 3)
+    if !self.messageUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.messageUid, fieldNumber: 3)
+    }
+    if self.maxReplies != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxReplies, fieldNumber: 4)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: FLO_SOURCE, rhs: FLO_SOURCE) -> Bool {
+  public static func ==(lhs: FlotgThreadRequest, rhs: FlotgThreadRequest) -> Bool {
     if lhs.flags != rhs.flags {return false}
     if lhs.sourceUid != rhs.sourceUid {return false}
-    if lhs.title != rhs.title {return false}
+    if lhs.messageUid != rhs.messageUid {return false}
+    if lhs.maxReplies != rhs.maxReplies {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension FLO_MESSAGE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = "FLO_MESSAGE"
+extension FlotgTopic: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgTopic"
   public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
-    1: .same(proto: "flags"),
-    2: .standard(proto: "source_uid"),
+    1: .standard(proto: "source_uid"),
+    2: .standard(proto: "topic_id"),
     3: .same(proto: "title"),
-    5: .standard(proto: "message_uid"),
-    6: .standard(proto: "created_at"),
-    7: .same(proto: "text"),
-    8: .standard(proto: "message_links"),
+    4: .standard(proto: "created_at"),
+    5: .same(proto: "closed"),
+    6: .same(proto: "pinned"),
+    7: .same(proto: "hidden"),
+    8: .standard(proto: "top_message_uid"),
   ]
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
@@ -260,13 +2781,14 @@ extension FLO_MESSAGE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementati
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
-      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
-      case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      case 1: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.topicID) }()
       case 3: try { try decoder.decodeSingularStringField(value: &self.title) }()
-      case 5: try { try decoder.decodeSingularStringField(value: &self.messageUid) }()
-      case 6: try { try decoder.decodeSingularMessageField(value: &self._createdAt) }()
-      case 7: try { try decoder.decodeSingularStringField(value: &self.text) }()
-      case 8: try { try decoder.decodeRepeatedStringField(value: &self.messageLinks) }()
+      case 4: try { try decoder.decodeSingularMessageField(value: &self._createdAt) }()
+      case 5: try { try decoder.decodeSingularBoolField(value: &self.closed) }()
+      case 6: try { try decoder.decodeSingularBoolField(value: &self.pinned) }()
+      case 7: try { try decoder.decodeSingularBoolField(value: &self.hidden) }()
+      case 8: try { try decoder.decodeSingularStringField(value: &self.topMessageUid) }()
       default: break
       }
     }
@@ -277,49 +2799,54 @@ extension FLO_MESSAGE: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementati
     // allocates stack space for every if/case branch local when no optimizations
     // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
     // https://github.com/apple/swift-protobuf/issues/1182
-    if self.flags != 0 {
-      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
-    }
     if !self.sourceUid.isEmpty {
-      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
+      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 1)
+    }
+    if self.topicID != 0 {
+      try visitor.visitSingularInt32Field(value: self.topicID, fieldNumber: 2)
     }
     if !self.title.isEmpty {
       try visitor.visitSingularStringField(value: self.title, fieldNumber: 3)
     }
-    if !self.messageUid.isEmpty {
-      try visitor.visitSingularStringField(value: self.messageUid, fieldNumber: 5)
-    }
     try { if let v = self._createdAt {
-      try visitor.visitSingularMessageField(value: v, fieldNumber: 6)
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 4)
     } }()
-    if !self.text.isEmpty {
-      try visitor.visitSingularStringField(value: self.text, fieldNumber: 7)
+    if self.closed != false {
+      try visitor.visitSingularBoolField(value: self.closed, fieldNumber: 5)
+    }
+    if self.pinned != false {
+      try visitor.visitSingularBoolField(value: self.pinned, fieldNumber: 6)
+    }
+    if self.hidden != false {
+      try visitor.visitSingularBoolField(value: self.hidden, fieldNumber: 7)
     }
-    if !self.messageLinks.isEmpty {
-      try visitor.visitRepeatedStringField(value: self.messageLinks, fieldNumber: 8)
+    if !self.topMessageUid.isEmpty {
+      try visitor.visitSingularStringField(value: self.topMessageUid, fieldNumber: 8)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: FLO_MESSAGE, rhs: FLO_MESSAGE) -> Bool {
-    if lhs.flags != rhs.flags {return false}
+  public static func ==(lhs: FlotgTopic, rhs: FlotgTopic) -> Bool {
     if lhs.sourceUid != rhs.sourceUid {return false}
+    if lhs.topicID != rhs.topicID {return false}
     if lhs.title != rhs.title {return false}
-    if lhs.messageUid != rhs.messageUid {return false}
     if lhs._createdAt != rhs._createdAt {return false}
-    if lhs.text != rhs.text {return false}
-    if lhs.messageLinks != rhs.messageLinks {return false}
+    if lhs.closed != rhs.closed {return false}
+    if lhs.pinned != rhs.pinned {return false}
+    if lhs.hidden != rhs.hidden {return false}
+    if lhs.topMessageUid != rhs.topMessageUid {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension FlotgGetSourcesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = "FlotgGetSourcesRequest"
+extension FlotgAuthRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgAuthRequest"
   public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
     1: .same(proto: "flags"),
-    2: .standard(proto: "source_uids"),
-    3: .standard(proto: "filter_flags"),
+    2: .same(proto: "phone"),
+    3: .same(proto: "code"),
+    4: .same(proto: "password"),
   ]
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
@@ -329,8 +2856,9 @@ extension FlotgGetSourcesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageI
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
       case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
-      case 2: try { try decoder.decodeRepeatedStringField(value: &self.sourceUids) }()
-      case 3: try { try decoder.decodeRepeatedInt32Field(value: &self.filterFlags) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.phone) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.code) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.password) }()
       default: break
       }
     }
@@ -340,30 +2868,34 @@ extension FlotgGetSourcesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageI
     if self.flags != 0 {
       try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
     }
-    if !self.sourceUids.isEmpty {
-      try visitor.visitRepeatedStringField(value: self.sourceUids, fieldNumber: 2)
+    if !self.phone.isEmpty {
+      try visitor.visitSingularStringField(value: self.phone, fieldNumber: 2)
     }
-    if !self.filterFlags.isEmpty {
-      try visitor.visitPackedInt32Field(value: self.filterFlags, fieldNumber: 3)
+    if !self.code.isEmpty {
+      try visitor.visitSingularStringField(value: self.code, fieldNumber: 3)
+    }
+    if !self.password.isEmpty {
+      try visitor.visitSingularStringField(value: self.password, fieldNumber: 4)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: FlotgGetSourcesRequest, rhs: FlotgGetSourcesRequest) -> Bool {
+  public static func ==(lhs: FlotgAuthRequest, rhs: FlotgAuthRequest) -> Bool {
     if lhs.flags != rhs.flags {return false}
-    if lhs.sourceUids != rhs.sourceUids {return false}
-    if lhs.filterFlags != rhs.filterFlags {return false}
+    if lhs.phone != rhs.phone {return false}
+    if lhs.code != rhs.code {return false}
+    if lhs.password != rhs.password {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension FlotgGetMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = "FlotgGetMessagesRequest"
+extension FlotgAuthStatus: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = "FlotgAuthStatus"
   public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
-    1: .same(proto: "flags"),
-    2: .standard(proto: "source_uid"),
-    3: .standard(proto: "filter_flags"),
+    1: .same(proto: "state"),
+    2: .same(proto: "error"),
+    3: .standard(proto: "rpc_login_enabled"),
   ]
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
@@ -372,31 +2904,31 @@ extension FlotgGetMessagesRequest: SwiftProtobuf.Message, SwiftProtobuf._Message
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
-      case 1: try { try decoder.decodeSingularInt32Field(value: &self.flags) }()
-      case 2: try { try decoder.decodeSingularStringField(value: &self.sourceUid) }()
-      case 3: try { try decoder.decodeRepeatedInt32Field(value: &self.filterFlags) }()
+      case 1: try { try decoder.decodeSingularEnumField(value: &self.state) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.error) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.rpcLoginEnabled) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    if self.flags != 0 {
-      try visitor.visitSingularInt32Field(value: self.flags, fieldNumber: 1)
+    if self.state != .authUnknown {
+      try visitor.visitSingularEnumField(value: self.state, fieldNumber: 1)
     }
-    if !self.sourceUid.isEmpty {
-      try visitor.visitSingularStringField(value: self.sourceUid, fieldNumber: 2)
+    if !self.error.isEmpty {
+      try visitor.visitSingularStringField(value: self.error, fieldNumber: 2)
     }
-    if !self.filterFlags.isEmpty {
-      try visitor.visitPackedInt32Field(value: self.filterFlags, fieldNumber: 3)
+    if self.rpcLoginEnabled != false {
+      try visitor.visitSingularBoolField(value: self.rpcLoginEnabled, fieldNumber: 3)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: FlotgGetMessagesRequest, rhs: FlotgGetMessagesRequest) -> Bool {
-    if lhs.flags != rhs.flags {return false}
-    if lhs.sourceUid != rhs.sourceUid {return false}
-    if lhs.filterFlags != rhs.filterFlags {return false}
+  public static func ==(lhs: FlotgAuthStatus, rhs: FlotgAuthStatus) -> Bool {
+    if lhs.state != rhs.state {return false}
+    if lhs.error != rhs.error {return false}
+    if lhs.rpcLoginEnabled != rhs.rpcLoginEnabled {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }